@@ -0,0 +1,115 @@
+// Comando precision compara o erro total de uma fórmula de diferenças finitas em float64 e
+// em precisão arbitrária, para uma varredura de h. A saída é um CSV (h, erro em float64,
+// erro em precisão arbitrária), desenhado pelo plotter.py.
+//
+// Função de teste: f(x) = 1/(1+x²), cujas derivadas exatas são conhecidas em forma fechada.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"math"
+	"math/big"
+	"os"
+
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/precise"
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/registry"
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/stencil"
+)
+
+var philosophies = map[string]stencil.Philosophy{
+	"forward":  stencil.Forward,
+	"backward": stencil.Backward,
+	"central":  stencil.Central,
+}
+
+func main() {
+	derivative := flag.Uint64("derivative", 1, "ordem da derivada (1 a 3)")
+	philosophy := flag.String("philosophy", "central", "forward, backward ou central")
+	order := flag.Uint64("order", 2, "ordem de erro da fórmula (1 a 4)")
+	x := flag.Float64("x", 0.7, "ponto de avaliação")
+	bits := flag.Uint("bits", 256, "precisão, em bits, da variante em big.Float")
+	perDecade := flag.Int("per-decade", 4, "passos por década")
+	minExp := flag.Int("min-exp", -30, "expoente do menor passo (10^min-exp)")
+	flag.Parse()
+
+	p, ok := philosophies[*philosophy]
+	if !ok {
+		log.Fatalf("filosofia desconhecida: %s", *philosophy)
+	}
+	if *derivative < 1 || *derivative > 3 {
+		log.Fatalf("a função de teste só tem derivadas exatas de ordem 1 a 3, recebido %d", *derivative)
+	}
+
+	method, err := registry.Lookup(*derivative, p, *order)
+	if err != nil {
+		log.Fatal(err)
+	}
+	formula, err := precise.New(method.(stencil.Describer), *bits)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	ctx := context.Background()
+	exact := rungeDerivative(int(*derivative), *x, *bits)
+	exact64, _ := exact.Float64()
+
+	fmt.Printf("h,erro_float64,erro_%dbits\n", *bits)
+	for i := 0; ; i++ {
+		e := -1 - float64(i)/float64(*perDecade)
+		if e < float64(*minExp) {
+			break
+		}
+		h := math.Pow(10, e)
+
+		got64, err := method.Calculate(ctx, func(x float64) float64 { return 1 / (1 + x*x) }, *x, h)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		got, err := formula.Calculate(ctx, runge, formula.Float(*x), formula.Float(h))
+		if err != nil {
+			log.Fatal(err)
+		}
+		errBig, _ := new(big.Float).SetPrec(*bits).Sub(got, exact).Float64()
+
+		fmt.Fprintf(os.Stdout, "%.6e,%.6e,%.6e\n", h, math.Abs(got64-exact64), math.Abs(errBig))
+	}
+}
+
+// runge é f(x) = 1/(1+x²) na precisão do argumento.
+func runge(x *big.Float) *big.Float {
+	prec := x.Prec()
+	den := new(big.Float).SetPrec(prec).Mul(x, x)
+	den.Add(den, big.NewFloat(1))
+	return new(big.Float).SetPrec(prec).Quo(big.NewFloat(1), den)
+}
+
+// rungeDerivative retorna a derivada de ordem n (1 a 3) de 1/(1+x²) em x.
+func rungeDerivative(n int, x float64, prec uint) *big.Float {
+	v := func(f float64) *big.Float { return new(big.Float).SetPrec(prec).SetFloat64(f) }
+	bx := v(x)
+	x2 := new(big.Float).SetPrec(prec).Mul(bx, bx)
+	u := new(big.Float).SetPrec(prec).Add(x2, v(1))
+
+	den := v(1)
+	for range n + 1 {
+		den.Mul(den, u)
+	}
+
+	num := v(0)
+	switch n {
+	case 1: // -2x/(1+x²)²
+		num.Mul(v(-2), bx)
+	case 2: // (6x² - 2)/(1+x²)³
+		num.Mul(v(6), x2)
+		num.Sub(num, v(2))
+	case 3: // 24x(1 - x²)/(1+x²)⁴
+		num.Sub(v(1), x2)
+		num.Mul(num, bx)
+		num.Mul(num, v(24))
+	}
+	return num.Quo(num, den)
+}
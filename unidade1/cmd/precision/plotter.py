@@ -0,0 +1,39 @@
+import subprocess
+import sys
+
+import matplotlib.pyplot as plt
+
+
+def run_go_and_plot():
+    """
+    Executa o comando precision e desenha o erro total em função de h, em escala log-log,
+    para float64 e para a precisão arbitrária. Argumentos extras são repassados ao Go,
+    por exemplo: python plotter.py -derivative 2 -order 4
+    """
+    result = subprocess.run(
+        ["go", "run", ".", *sys.argv[1:]], capture_output=True, text=True, check=True
+    )
+
+    lines = result.stdout.strip().split("\n")
+    header = lines[0].split(",")
+    rows = [[float(v) for v in line.split(",")] for line in lines[1:] if line]
+
+    hs = [row[0] for row in rows]
+    plt.figure(figsize=(12, 7))
+    for col, marker in ((1, "o"), (2, "s")):
+        # Erros nulos ou não finitos não aparecem em escala logarítmica.
+        points = [(h, row[col]) for h, row in zip(hs, rows) if 0 < row[col] < float("inf")]
+        plt.loglog(*zip(*points), marker=marker, linestyle="-", label=header[col])
+
+    plt.title("Erro total × h: truncamento e arredondamento", fontsize=16)
+    plt.xlabel("h", fontsize=12)
+    plt.ylabel("|erro|", fontsize=12)
+    plt.gca().invert_xaxis()
+    plt.grid(True, which="both", linestyle="--", linewidth=0.5)
+    plt.legend()
+    plt.savefig("precision.png", dpi=150)
+    plt.show()
+
+
+if __name__ == "__main__":
+    run_go_and_plot()
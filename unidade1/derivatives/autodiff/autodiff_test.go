@@ -0,0 +1,89 @@
+package autodiff_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/autodiff"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDual_elementaryFunctions(t *testing.T) {
+	t.Parallel()
+
+	x := 0.8
+
+	tests := []struct {
+		name     string
+		f        func(autodiff.Dual) autodiff.Dual
+		expected float64
+	}{
+		{"Sin", autodiff.Dual.Sin, math.Cos(x)},
+		{"Cos", autodiff.Dual.Cos, -math.Sin(x)},
+		{"Tan", autodiff.Dual.Tan, 1 / (math.Cos(x) * math.Cos(x))},
+		{"Exp", autodiff.Dual.Exp, math.Exp(x)},
+		{"Log", autodiff.Dual.Log, 1 / x},
+		{"Sqrt", autodiff.Dual.Sqrt, 0.5 / math.Sqrt(x)},
+		{"PowReal", func(d autodiff.Dual) autodiff.Dual { return d.PowReal(3.5) }, 3.5 * math.Pow(x, 2.5)},
+		{"Pow", func(d autodiff.Dual) autodiff.Dual { return d.Pow(d) }, math.Pow(x, x) * (math.Log(x) + 1)},
+		{"Quotient", func(d autodiff.Dual) autodiff.Dual {
+			return d.Mul(d).Div(d.Add(autodiff.Constant(1)))
+		}, (x*x + 2*x) / ((x + 1) * (x + 1))},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.InDelta(t, tc.expected, autodiff.Derivative(tc.f, x), 1e-14)
+		})
+	}
+}
+
+func TestTaylor_higherDerivatives(t *testing.T) {
+	t.Parallel()
+
+	x := 0.8
+
+	tests := []struct {
+		name     string
+		f        func(autodiff.Taylor) autodiff.Taylor
+		expected []float64
+	}{
+		{"Sin", autodiff.Taylor.Sin, []float64{math.Sin(x), math.Cos(x), -math.Sin(x), -math.Cos(x)}},
+		{"Exp", autodiff.Taylor.Exp, []float64{math.Exp(x), math.Exp(x), math.Exp(x), math.Exp(x)}},
+		{"Log", autodiff.Taylor.Log, []float64{math.Log(x), 1 / x, -1 / (x * x), 2 / (x * x * x)}},
+		{"Sqrt", autodiff.Taylor.Sqrt, []float64{
+			math.Sqrt(x), 0.5 * math.Pow(x, -0.5), -0.25 * math.Pow(x, -1.5), 0.375 * math.Pow(x, -2.5),
+		}},
+		{"Quartic", func(v autodiff.Taylor) autodiff.Taylor {
+			return v.Mul(v).Mul(v).Mul(v)
+		}, []float64{math.Pow(x, 4), 4 * x * x * x, 12 * x * x, 24 * x}},
+		{"Tan", autodiff.Taylor.Tan, func() []float64 {
+			sec2 := 1 / (math.Cos(x) * math.Cos(x))
+			tan := math.Tan(x)
+			return []float64{tan, sec2, 2 * sec2 * tan, 2*sec2*sec2 + 4*sec2*tan*tan}
+		}()},
+		{"Pow", func(v autodiff.Taylor) autodiff.Taylor {
+			return v.Pow(autodiff.TaylorConstant(3, v.Degree()))
+		}, []float64{x * x * x, 3 * x * x, 6 * x, 6}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.InDeltaSlice(t, tc.expected, autodiff.Derivatives(tc.f, x, 3), 1e-12)
+		})
+	}
+}
+
+func TestTaylor_agreesWithDual(t *testing.T) {
+	t.Parallel()
+
+	x := 1.3
+	dual := autodiff.Derivative(func(d autodiff.Dual) autodiff.Dual {
+		return d.Sin().Mul(d.Exp()).Div(d.Sqrt())
+	}, x)
+	taylor := autodiff.NthDerivative(func(v autodiff.Taylor) autodiff.Taylor {
+		return v.Sin().Mul(v.Exp()).Div(v.Sqrt())
+	}, x, 1)
+
+	assert.InDelta(t, dual, taylor, 1e-14)
+}
@@ -0,0 +1,108 @@
+// Package autodiff implementa diferenciação automática no modo direto, com números duais
+// (primeira derivada) e aritmética de séries de Taylor truncadas (derivadas de qualquer
+// ordem). Serve de referência exata para validar as fórmulas de diferenças finitas.
+package autodiff
+
+import "math"
+
+// Dual é um número dual a + bε, com ε² = 0. Avaliar f em Variable(x) produz
+// f(x) + f'(x)ε.
+type Dual struct {
+	// Re é a parte real, o valor da função.
+	Re float64
+	// Eps é a parte infinitesimal, o valor da derivada.
+	Eps float64
+}
+
+// Variable retorna o dual que representa a variável independente em x.
+func Variable(x float64) Dual {
+	return Dual{Re: x, Eps: 1}
+}
+
+// Constant retorna o dual que representa uma constante.
+func Constant(c float64) Dual {
+	return Dual{Re: c}
+}
+
+// Derivative retorna f'(x) avaliando f em números duais.
+func Derivative(f func(Dual) Dual, x float64) float64 {
+	return f(Variable(x)).Eps
+}
+
+// Add retorna a + b.
+func (a Dual) Add(b Dual) Dual {
+	return Dual{a.Re + b.Re, a.Eps + b.Eps}
+}
+
+// Sub retorna a - b.
+func (a Dual) Sub(b Dual) Dual {
+	return Dual{a.Re - b.Re, a.Eps - b.Eps}
+}
+
+// Mul retorna a · b.
+func (a Dual) Mul(b Dual) Dual {
+	return Dual{a.Re * b.Re, a.Re*b.Eps + a.Eps*b.Re}
+}
+
+// Div retorna a / b.
+func (a Dual) Div(b Dual) Dual {
+	return Dual{a.Re / b.Re, (a.Eps*b.Re - a.Re*b.Eps) / (b.Re * b.Re)}
+}
+
+// Neg retorna -a.
+func (a Dual) Neg() Dual {
+	return Dual{-a.Re, -a.Eps}
+}
+
+// Scale retorna c · a.
+func (a Dual) Scale(c float64) Dual {
+	return Dual{c * a.Re, c * a.Eps}
+}
+
+// chain aplica a regra da cadeia para g com valor v e derivada dv no ponto a.Re.
+func (a Dual) chain(v, dv float64) Dual {
+	return Dual{v, dv * a.Eps}
+}
+
+// Sin retorna sen(a).
+func (a Dual) Sin() Dual {
+	return a.chain(math.Sin(a.Re), math.Cos(a.Re))
+}
+
+// Cos retorna cos(a).
+func (a Dual) Cos() Dual {
+	return a.chain(math.Cos(a.Re), -math.Sin(a.Re))
+}
+
+// Tan retorna tg(a).
+func (a Dual) Tan() Dual {
+	t := math.Tan(a.Re)
+	return a.chain(t, 1+t*t)
+}
+
+// Exp retorna eᵃ.
+func (a Dual) Exp() Dual {
+	e := math.Exp(a.Re)
+	return a.chain(e, e)
+}
+
+// Log retorna ln(a).
+func (a Dual) Log() Dual {
+	return a.chain(math.Log(a.Re), 1/a.Re)
+}
+
+// Sqrt retorna √a.
+func (a Dual) Sqrt() Dual {
+	s := math.Sqrt(a.Re)
+	return a.chain(s, 0.5/s)
+}
+
+// PowReal retorna aʳ para um expoente real constante.
+func (a Dual) PowReal(r float64) Dual {
+	return a.chain(math.Pow(a.Re, r), r*math.Pow(a.Re, r-1))
+}
+
+// Pow retorna aᵇ = exp(b·ln a), com a > 0.
+func (a Dual) Pow(b Dual) Dual {
+	return b.Mul(a.Log()).Exp()
+}
@@ -0,0 +1,219 @@
+package autodiff
+
+import "math"
+
+// Taylor é uma série de Taylor truncada c₀ + c₁t + ... + cₙtⁿ em torno de um ponto.
+// Avaliar f em TaylorVariable(x, n) produz os coeficientes cₖ = f⁽ᵏ⁾(x)/k!.
+//
+// Todos os operandos de uma mesma expressão devem ter o mesmo grau.
+type Taylor struct {
+	// c são os coeficientes normalizados da série.
+	c []float64
+}
+
+// TaylorVariable retorna a série da variável independente em x, truncada no grau degree.
+func TaylorVariable(x float64, degree int) Taylor {
+	c := make([]float64, degree+1)
+	c[0] = x
+	if degree > 0 {
+		c[1] = 1
+	}
+	return Taylor{c: c}
+}
+
+// TaylorConstant retorna a série de uma constante, truncada no grau degree.
+func TaylorConstant(v float64, degree int) Taylor {
+	c := make([]float64, degree+1)
+	c[0] = v
+	return Taylor{c: c}
+}
+
+// Derivatives retorna f(x), f'(x), ..., f⁽ⁿ⁾(x).
+func Derivatives(f func(Taylor) Taylor, x float64, n int) []float64 {
+	res := f(TaylorVariable(x, n))
+
+	out := make([]float64, n+1)
+	factorial := 1.0
+	for k := range out {
+		if k > 0 {
+			factorial *= float64(k)
+		}
+		out[k] = res.c[k] * factorial
+	}
+	return out
+}
+
+// NthDerivative retorna f⁽ⁿ⁾(x).
+func NthDerivative(f func(Taylor) Taylor, x float64, n int) float64 {
+	return Derivatives(f, x, n)[n]
+}
+
+// Degree retorna o grau de truncamento da série.
+func (a Taylor) Degree() int {
+	return len(a.c) - 1
+}
+
+// Coefficient retorna o coeficiente cₖ.
+func (a Taylor) Coefficient(k int) float64 {
+	return a.c[k]
+}
+
+// Value retorna c₀, o valor da função no ponto.
+func (a Taylor) Value() float64 {
+	return a.c[0]
+}
+
+func (a Taylor) like() Taylor {
+	return Taylor{c: make([]float64, len(a.c))}
+}
+
+// Add retorna a + b.
+func (a Taylor) Add(b Taylor) Taylor {
+	out := a.like()
+	for k := range out.c {
+		out.c[k] = a.c[k] + b.c[k]
+	}
+	return out
+}
+
+// Sub retorna a - b.
+func (a Taylor) Sub(b Taylor) Taylor {
+	out := a.like()
+	for k := range out.c {
+		out.c[k] = a.c[k] - b.c[k]
+	}
+	return out
+}
+
+// Neg retorna -a.
+func (a Taylor) Neg() Taylor {
+	return a.Scale(-1)
+}
+
+// Scale retorna v · a.
+func (a Taylor) Scale(v float64) Taylor {
+	out := a.like()
+	for k := range out.c {
+		out.c[k] = v * a.c[k]
+	}
+	return out
+}
+
+// AddConst retorna a + v.
+func (a Taylor) AddConst(v float64) Taylor {
+	out := a.Scale(1)
+	out.c[0] += v
+	return out
+}
+
+// Mul retorna a · b (produto de Cauchy truncado).
+func (a Taylor) Mul(b Taylor) Taylor {
+	out := a.like()
+	for k := range out.c {
+		var sum float64
+		for i := 0; i <= k; i++ {
+			sum += a.c[i] * b.c[k-i]
+		}
+		out.c[k] = sum
+	}
+	return out
+}
+
+// Div retorna a / b.
+func (a Taylor) Div(b Taylor) Taylor {
+	out := a.like()
+	for k := range out.c {
+		sum := a.c[k]
+		for i := 1; i <= k; i++ {
+			sum -= b.c[i] * out.c[k-i]
+		}
+		out.c[k] = sum / b.c[0]
+	}
+	return out
+}
+
+// Exp retorna eᵃ.
+func (a Taylor) Exp() Taylor {
+	out := a.like()
+	out.c[0] = math.Exp(a.c[0])
+	for k := 1; k < len(out.c); k++ {
+		var sum float64
+		for j := 1; j <= k; j++ {
+			sum += float64(j) * a.c[j] * out.c[k-j]
+		}
+		out.c[k] = sum / float64(k)
+	}
+	return out
+}
+
+// Log retorna ln(a).
+func (a Taylor) Log() Taylor {
+	out := a.like()
+	out.c[0] = math.Log(a.c[0])
+	for k := 1; k < len(out.c); k++ {
+		sum := a.c[k]
+		for j := 1; j < k; j++ {
+			sum -= float64(j) / float64(k) * out.c[j] * a.c[k-j]
+		}
+		out.c[k] = sum / a.c[0]
+	}
+	return out
+}
+
+// sinCos retorna sen(a) e cos(a), que dependem um do outro na recorrência.
+func (a Taylor) sinCos() (Taylor, Taylor) {
+	s, c := a.like(), a.like()
+	s.c[0], c.c[0] = math.Sincos(a.c[0])
+	for k := 1; k < len(a.c); k++ {
+		var ss, cc float64
+		for j := 1; j <= k; j++ {
+			ss += float64(j) * a.c[j] * c.c[k-j]
+			cc -= float64(j) * a.c[j] * s.c[k-j]
+		}
+		s.c[k] = ss / float64(k)
+		c.c[k] = cc / float64(k)
+	}
+	return s, c
+}
+
+// Sin retorna sen(a).
+func (a Taylor) Sin() Taylor {
+	s, _ := a.sinCos()
+	return s
+}
+
+// Cos retorna cos(a).
+func (a Taylor) Cos() Taylor {
+	_, c := a.sinCos()
+	return c
+}
+
+// Tan retorna tg(a).
+func (a Taylor) Tan() Taylor {
+	s, c := a.sinCos()
+	return s.Div(c)
+}
+
+// PowReal retorna aʳ para um expoente real constante, com a₀ ≠ 0.
+func (a Taylor) PowReal(r float64) Taylor {
+	out := a.like()
+	out.c[0] = math.Pow(a.c[0], r)
+	for k := 1; k < len(out.c); k++ {
+		var sum float64
+		for j := 1; j <= k; j++ {
+			sum += ((r+1)*float64(j) - float64(k)) * a.c[j] * out.c[k-j]
+		}
+		out.c[k] = sum / (float64(k) * a.c[0])
+	}
+	return out
+}
+
+// Sqrt retorna √a.
+func (a Taylor) Sqrt() Taylor {
+	return a.PowReal(0.5)
+}
+
+// Pow retorna aᵇ = exp(b·ln a), com a₀ > 0.
+func (a Taylor) Pow(b Taylor) Taylor {
+	return b.Mul(a.Log()).Exp()
+}
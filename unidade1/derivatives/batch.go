@@ -0,0 +1,169 @@
+package derivatives
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// Memo guarda as avaliações de uma Func, para que pontos de estêncil compartilhados entre
+// abscissas vizinhas sejam calculados uma única vez. É seguro para uso concorrente:
+// avaliações simultâneas do mesmo ponto esperam pela primeira.
+//
+// Os pontos são comparados exatamente. Para que haja reaproveitamento, as abscissas e o
+// passo devem ser representáveis sem arredondamento, por exemplo xs[i] = i·h com h potência de 2.
+type Memo struct {
+	// f é a função original.
+	f Func
+	// mu protege values.
+	mu sync.Mutex
+	// values guarda uma entrada por ponto avaliado.
+	values map[float64]*memoEntry
+	// hits e misses contam as consultas atendidas pelo cache e as avaliações de f.
+	hits, misses atomic.Int64
+}
+
+// memoEntry é o valor de f em um ponto, calculado uma única vez.
+type memoEntry struct {
+	once  sync.Once
+	value float64
+}
+
+// NewMemo cria o cache de avaliações de f.
+func NewMemo(f Func) *Memo {
+	return &Memo{
+		f:      f,
+		values: make(map[float64]*memoEntry),
+	}
+}
+
+// Eval retorna f(x), avaliando f apenas na primeira consulta de x.
+func (m *Memo) Eval(x float64) float64 {
+	m.mu.Lock()
+	entry, ok := m.values[x]
+	if !ok {
+		entry = &memoEntry{}
+		m.values[x] = entry
+	}
+	m.mu.Unlock()
+
+	if ok {
+		m.hits.Add(1)
+	}
+	entry.once.Do(func() {
+		m.misses.Add(1)
+		entry.value = m.f(x)
+	})
+	return entry.value
+}
+
+// Func retorna Eval como uma Func.
+func (m *Memo) Func() Func {
+	return m.Eval
+}
+
+// Stats retorna quantas consultas foram atendidas pelo cache e quantas avaliaram f.
+func (m *Memo) Stats() (hits, misses int64) {
+	return m.hits.Load(), m.misses.Load()
+}
+
+// batchConfig reúne as opções de Batch.
+type batchConfig struct {
+	// workers é o número máximo de cálculos simultâneos.
+	workers int
+	// memo, se não nulo, substitui f nas avaliações.
+	memo *Memo
+}
+
+// BatchOption configura um Batch.
+type BatchOption func(*batchConfig)
+
+// WithWorkers limita a quantidade de derivadas calculadas simultaneamente.
+// O padrão é runtime.GOMAXPROCS(0).
+func WithWorkers(workers int) BatchOption {
+	return func(c *batchConfig) {
+		c.workers = workers
+	}
+}
+
+// WithMemo faz as avaliações passarem pelo cache memo, que pode ser reaproveitado entre
+// chamadas. O cache deve ter sido criado com a mesma função passada ao Batch.
+func WithMemo(memo *Memo) BatchOption {
+	return func(c *batchConfig) {
+		c.memo = memo
+	}
+}
+
+// Batch calcula a derivada de f por method em cada abscissa de xs, com passo h, usando um
+// conjunto limitado de goroutines. O resultado out[i] corresponde a xs[i].
+//
+// Ao cancelar ctx, nenhuma nova abscissa é iniciada e Batch retorna ctx.Err() assim que os
+// cálculos em andamento terminam. O primeiro erro de method também interrompe o lote.
+func Batch(ctx context.Context, method DerivativeInterface, f Func, xs []float64, h float64, opts ...BatchOption) ([]float64, error) {
+	cfg := batchConfig{workers: runtime.GOMAXPROCS(0)}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if cfg.workers < 1 {
+		return nil, fmt.Errorf("derivatives: número de workers inválido: %d", cfg.workers)
+	}
+	if cfg.memo != nil {
+		f = cfg.memo.Func()
+	}
+
+	slog.DebugContext(ctx, "Calculando derivadas em lote",
+		slog.Int("pontos", len(xs)),
+		slog.Int("workers", cfg.workers),
+		slog.Bool("memo", cfg.memo != nil))
+
+	batchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		out      = make([]float64, len(xs))
+		jobs     = make(chan int)
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+
+	for range min(cfg.workers, len(xs)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				v, err := method.Calculate(batchCtx, f, xs[i], h)
+				if err != nil {
+					errOnce.Do(func() {
+						firstErr = fmt.Errorf("derivatives: x[%d]=%g: %w", i, xs[i], err)
+					})
+					cancel()
+					continue
+				}
+				out[i] = v
+			}
+		}()
+	}
+
+feed:
+	for i := range xs {
+		select {
+		case jobs <- i:
+		case <-batchCtx.Done():
+			break feed
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -0,0 +1,201 @@
+// Package convergence verifica empiricamente a ordem de convergência de fórmulas de
+// derivada: varre h por várias décadas em funções de derivadas conhecidas, ajusta a
+// inclinação de log(erro) × log(h) e compara com a ordem anunciada.
+package convergence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives"
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/autodiff"
+)
+
+// ErrTooFewSamples indica que a varredura não teve passos suficientes na região dominada
+// pelo erro de truncamento para ajustar a inclinação.
+var ErrTooFewSamples = errors.New("convergence: amostras insuficientes na região de truncamento")
+
+// minSamples é o número mínimo de passos usados no ajuste.
+const minSamples = 3
+
+// slopeSpread é a maior diferença admitida entre as inclinações locais de uma mesma faixa
+// de ajuste.
+const slopeSpread = 1.0
+
+// Case é uma fórmula a ser verificada.
+type Case struct {
+	// Name identifica a fórmula no relatório.
+	Name string
+	// Method é a fórmula.
+	Method derivatives.DerivativeInterface
+	// Derivative é a ordem da derivada que a fórmula aproxima.
+	Derivative int
+	// Advertised é a ordem de erro anunciada.
+	Advertised float64
+}
+
+// TestFunction é uma função de derivadas conhecidas, escrita em aritmética de Taylor para
+// que as derivadas exatas venham da diferenciação automática.
+type TestFunction struct {
+	// Name identifica a função no relatório.
+	Name string
+	// F é a função.
+	F func(autodiff.Taylor) autodiff.Taylor
+	// X é o ponto de avaliação.
+	X float64
+}
+
+// Sweep descreve a varredura geométrica de h, de HMax até HMin.
+type Sweep struct {
+	// HMax é o maior passo.
+	HMax float64
+	// HMin é o menor passo.
+	HMin float64
+	// PerDecade é a quantidade de passos por década.
+	PerDecade int
+}
+
+// DefaultSweep varre h de 10⁻¹ a 10⁻⁵ com quatro passos por década.
+var DefaultSweep = Sweep{HMax: 1e-1, HMin: 1e-5, PerDecade: 4}
+
+// Sample é um ponto da varredura.
+type Sample struct {
+	// H é o passo.
+	H float64
+	// Error é o erro absoluto da fórmula com esse passo.
+	Error float64
+	// Fitted indica se o ponto entrou no ajuste da inclinação.
+	Fitted bool
+}
+
+// Result é o resultado da verificação de uma fórmula em uma função.
+type Result struct {
+	// Case é o nome da fórmula.
+	Case string
+	// Function é o nome da função de teste.
+	Function string
+	// Advertised é a ordem anunciada.
+	Advertised float64
+	// Observed é a inclinação ajustada de log(erro) × log(h).
+	Observed float64
+	// Samples são os pontos da varredura.
+	Samples []Sample
+}
+
+// Agrees indica se a ordem observada difere da anunciada em no máximo tolerance, para cima
+// ou para baixo: uma descrição que subestima a ordem também é um erro. Fórmulas que
+// superconvergem, como as centrais de ordem ímpar, devem anunciar a ordem esperada.
+func (r Result) Agrees(tolerance float64) bool {
+	return math.Abs(r.Observed-r.Advertised) <= tolerance
+}
+
+// Run varre h, calcula o erro da fórmula em cada passo e ajusta a ordem observada.
+//
+// O ajuste usa a maior faixa contígua de passos em que as inclinações locais são positivas
+// e diferem entre si em no máximo slopeSpread. Isso descarta os passos grandes, ainda fora
+// do regime assintótico, e os pequenos, em que o arredondamento domina.
+func Run(ctx context.Context, c Case, fn TestFunction, sweep Sweep) (Result, error) {
+	if !(sweep.HMax > sweep.HMin) || !(sweep.HMin > 0) || sweep.PerDecade < 1 {
+		return Result{}, fmt.Errorf("convergence: varredura inválida: %+v", sweep)
+	}
+
+	f := func(x float64) float64 {
+		return fn.F(autodiff.TaylorConstant(x, 0)).Value()
+	}
+	exact := autodiff.NthDerivative(fn.F, fn.X, c.Derivative)
+
+	decades := math.Log10(sweep.HMax / sweep.HMin)
+	count := int(math.Round(decades*float64(sweep.PerDecade))) + 1
+
+	res := Result{
+		Case:       c.Name,
+		Function:   fn.Name,
+		Advertised: c.Advertised,
+		Samples:    make([]Sample, 0, count),
+	}
+	for i := range count {
+		if err := ctx.Err(); err != nil {
+			return res, err
+		}
+
+		h := sweep.HMax * math.Pow(10, -float64(i)/float64(sweep.PerDecade))
+		got, err := c.Method.Calculate(ctx, f, fn.X, h)
+		if err != nil {
+			return res, err
+		}
+		res.Samples = append(res.Samples, Sample{H: h, Error: math.Abs(got - exact)})
+	}
+
+	start, end := truncationRange(res.Samples)
+	if end-start < minSamples {
+		return res, fmt.Errorf("%w: %s em %s (%d pontos)", ErrTooFewSamples, c.Name, fn.Name, end-start)
+	}
+
+	logH := make([]float64, 0, end-start)
+	logErr := make([]float64, 0, end-start)
+	for i := start; i < end; i++ {
+		res.Samples[i].Fitted = true
+		logH = append(logH, math.Log(res.Samples[i].H))
+		logErr = append(logErr, math.Log(res.Samples[i].Error))
+	}
+	res.Observed = slope(logH, logErr)
+
+	slog.DebugContext(ctx, "Ordem de convergência observada",
+		slog.String("formula", c.Name),
+		slog.String("funcao", fn.Name),
+		slog.Float64("anunciada", c.Advertised),
+		slog.Float64("observada", res.Observed))
+
+	return res, nil
+}
+
+// truncationRange retorna o intervalo [start, end) da maior faixa de amostras dominada
+// pelo truncamento. Em caso de empate, vence a faixa de passos maiores.
+func truncationRange(samples []Sample) (int, int) {
+	local := make([]float64, len(samples)-1)
+	for i := range local {
+		a, b := samples[i], samples[i+1]
+		if a.Error == 0 || b.Error == 0 {
+			local[i] = math.NaN()
+			continue
+		}
+		local[i] = math.Log(a.Error/b.Error) / math.Log(a.H/b.H)
+	}
+
+	bestStart, bestEnd := 0, 0
+	for i := range local {
+		lo, hi := math.Inf(1), math.Inf(-1)
+		j := i
+		for ; j < len(local); j++ {
+			s := local[j]
+			if !(s > 0) {
+				break
+			}
+			lo, hi = math.Min(lo, s), math.Max(hi, s)
+			if hi-lo > slopeSpread {
+				break
+			}
+		}
+		// As inclinações local[i:j] ligam as amostras i..j.
+		if j > i && j-i+1 > bestEnd-bestStart {
+			bestStart, bestEnd = i, j+1
+		}
+	}
+	return bestStart, bestEnd
+}
+
+// slope retorna a inclinação da reta de mínimos quadrados por (xs, ys).
+func slope(xs, ys []float64) float64 {
+	n := float64(len(xs))
+	var sx, sy, sxx, sxy float64
+	for i := range xs {
+		sx += xs[i]
+		sy += ys[i]
+		sxx += xs[i] * xs[i]
+		sxy += xs[i] * ys[i]
+	}
+	return (n*sxy - sx*sy) / (n*sxx - sx*sx)
+}
@@ -0,0 +1,149 @@
+package convergence_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"testing"
+
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/autodiff"
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/convergence"
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/first"
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/registry"
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/stencil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// orderTolerance é o quanto a ordem observada pode se afastar da esperada, para cima ou
+// para baixo.
+const orderTolerance = 0.3
+
+// superconvergent lista as fórmulas que convergem mais rápido que o anunciado, com a ordem
+// observada esperada: nas fórmulas centrais de ordem ímpar, a simetria do estêncil cancela
+// o termo de erro de ordem ímpar.
+var superconvergent = map[registry.Key]float64{
+	{Derivative: 1, Philosophy: stencil.Central, ErrorOrder: 1}: 2,
+	{Derivative: 1, Philosophy: stencil.Central, ErrorOrder: 3}: 4,
+	{Derivative: 2, Philosophy: stencil.Central, ErrorOrder: 1}: 2,
+	{Derivative: 2, Philosophy: stencil.Central, ErrorOrder: 3}: 4,
+	{Derivative: 3, Philosophy: stencil.Central, ErrorOrder: 1}: 2,
+	{Derivative: 3, Philosophy: stencil.Central, ErrorOrder: 3}: 4,
+	{Derivative: 4, Philosophy: stencil.Central, ErrorOrder: 1}: 2,
+	{Derivative: 4, Philosophy: stencil.Central, ErrorOrder: 3}: 4,
+}
+
+// testFunctions têm todas as derivadas usadas longe de zero no ponto X; um termo dominante
+// quase nulo faria a ordem observada subir.
+var testFunctions = []convergence.TestFunction{
+	{
+		Name: "exp(x)",
+		F:    func(x autodiff.Taylor) autodiff.Taylor { return x.Exp() },
+		X:    0.7,
+	},
+	{
+		Name: "x·cos x",
+		F:    func(x autodiff.Taylor) autodiff.Taylor { return x.Mul(x.Cos()) },
+		X:    0.9,
+	},
+}
+
+// handWrittenCases retorna todas as fórmulas do registro. A ordem anunciada é a do registro
+// ou, nas fórmulas de superconvergent, a ordem esperada listada ali.
+func handWrittenCases(t *testing.T) []convergence.Case {
+	var cases []convergence.Case
+	for _, key := range registry.Keys() {
+		method, err := registry.Lookup(key.Derivative, key.Philosophy, key.ErrorOrder)
+		require.NoError(t, err)
+
+		advertised := float64(key.ErrorOrder)
+		if expected, ok := superconvergent[key]; ok {
+			advertised = expected
+		}
+		cases = append(cases, convergence.Case{
+			Name:       key.String(),
+			Method:     method,
+			Derivative: int(key.Derivative),
+			Advertised: advertised,
+		})
+	}
+	return cases
+}
+
+func TestConvergence_handWrittenFormulas(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var results []convergence.Result
+	for _, c := range handWrittenCases(t) {
+		for _, fn := range testFunctions {
+			t.Run(c.Name+"/"+fn.Name, func(t *testing.T) {
+				res, err := convergence.Run(ctx, c, fn, convergence.DefaultSweep)
+				require.NoError(t, err)
+				results = append(results, res)
+
+				assert.Truef(t, res.Agrees(orderTolerance),
+					"%s em %s: ordem anunciada %g, observada %.2f", c.Name, fn.Name, res.Advertised, res.Observed)
+			})
+		}
+	}
+
+	var report bytes.Buffer
+	require.NoError(t, convergence.WriteMarkdown(&report, results, orderTolerance))
+	t.Log("\n" + report.String())
+}
+
+func TestConvergence_detectsWrongCoefficients(t *testing.T) {
+	t.Parallel()
+
+	// Anunciar O(h²) para a fórmula progressiva O(h) deve ser detectado.
+	res, err := convergence.Run(context.Background(), convergence.Case{
+		Name:       "FirstForwardO1",
+		Method:     first.NewForward(1),
+		Derivative: 1,
+		Advertised: 2,
+	}, testFunctions[0], convergence.DefaultSweep)
+	require.NoError(t, err)
+
+	assert.InDelta(t, 1.0, res.Observed, orderTolerance)
+	assert.False(t, res.Agrees(orderTolerance))
+
+	// Anunciar O(h) para a fórmula progressiva O(h²) também.
+	res, err = convergence.Run(context.Background(), convergence.Case{
+		Name:       "FirstForwardO2",
+		Method:     first.NewForward(2),
+		Derivative: 1,
+		Advertised: 1,
+	}, testFunctions[0], convergence.DefaultSweep)
+	require.NoError(t, err)
+
+	assert.InDelta(t, 2.0, res.Observed, orderTolerance)
+	assert.False(t, res.Agrees(orderTolerance))
+}
+
+func TestConvergence_reports(t *testing.T) {
+	t.Parallel()
+
+	res, err := convergence.Run(context.Background(), convergence.Case{
+		Name:       "FirstCentralO2",
+		Method:     first.NewCentral(2),
+		Derivative: 1,
+		Advertised: 2,
+	}, testFunctions[1], convergence.Sweep{HMax: 1e-1, HMin: 1e-3, PerDecade: 2})
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, convergence.WriteCSV(&buf, []convergence.Result{res}))
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 1+len(res.Samples))
+	assert.Equal(t, []string{"formula", "funcao", "anunciada", "observada", "h", "erro", "ajustado"}, records[0])
+	assert.Equal(t, "FirstCentralO2", records[1][0])
+
+	buf.Reset()
+	require.NoError(t, convergence.WriteMarkdown(&buf, []convergence.Result{res}, orderTolerance))
+	assert.Contains(t, buf.String(), "| FirstCentralO2 | x·cos x | 2 |")
+	assert.Contains(t, buf.String(), "| ok |")
+}
@@ -0,0 +1,59 @@
+package convergence
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// WriteCSV escreve um relatório CSV com uma linha por ponto da varredura.
+func WriteCSV(w io.Writer, results []Result) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"formula", "funcao", "anunciada", "observada", "h", "erro", "ajustado"}); err != nil {
+		return err
+	}
+
+	for _, r := range results {
+		for _, s := range r.Samples {
+			record := []string{
+				r.Case,
+				r.Function,
+				strconv.FormatFloat(r.Advertised, 'g', -1, 64),
+				strconv.FormatFloat(r.Observed, 'f', 3, 64),
+				strconv.FormatFloat(s.H, 'e', 3, 64),
+				strconv.FormatFloat(s.Error, 'e', 3, 64),
+				strconv.FormatBool(s.Fitted),
+			}
+			if err := cw.Write(record); err != nil {
+				return err
+			}
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteMarkdown escreve uma tabela markdown com a ordem anunciada e a observada de cada
+// fórmula, marcando as que discordam por mais que tolerance.
+func WriteMarkdown(w io.Writer, results []Result, tolerance float64) error {
+	if _, err := fmt.Fprintln(w, "| Fórmula | Função | Anunciada | Observada | Situação |"); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintln(w, "|---|---|---:|---:|---|"); err != nil {
+		return err
+	}
+
+	for _, r := range results {
+		status := "ok"
+		if !r.Agrees(tolerance) {
+			status = "**diverge**"
+		}
+		if _, err := fmt.Fprintf(w, "| %s | %s | %g | %.2f | %s |\n",
+			r.Case, r.Function, r.Advertised, r.Observed, status); err != nil {
+			return err
+		}
+	}
+	return nil
+}
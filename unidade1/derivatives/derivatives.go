@@ -1,10 +1,27 @@
 // Package derivatives provides interfaces for calculating derivatives of functions.
 package derivatives
 
-import "context"
+import (
+	"context"
+	"math/big"
+)
 
 type Func func(float64) float64
 
+// BigFunc é uma função avaliada em precisão arbitrária. O resultado deve ter pelo menos a
+// precisão do argumento.
+type BigFunc func(*big.Float) *big.Float
+
+// ComplexFunc é a extensão analítica de uma função real para o plano complexo.
+type ComplexFunc func(complex128) complex128
+
+// Real adapta a ComplexFunc para uma Func, restringindo-a ao eixo real.
+func (f ComplexFunc) Real() Func {
+	return func(x float64) float64 {
+		return real(f(complex(x, 0)))
+	}
+}
+
 type DerivativeInterface interface {
 	Calculate(context.Context, Func, float64, float64) (float64, error)
 }
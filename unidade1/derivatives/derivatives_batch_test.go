@@ -0,0 +1,157 @@
+package derivatives_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives"
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/first"
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/third"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// batchStep é uma potência de 2, para que xs[i] ± k·h sejam exatos e o cache os reconheça.
+const batchStep = 1.0 / 256
+
+func batchGrid(n int) []float64 {
+	xs := make([]float64, n)
+	for i := range xs {
+		xs[i] = float64(i) * batchStep
+	}
+	return xs
+}
+
+// methodFunc adapta uma função para DerivativeInterface.
+type methodFunc func(context.Context, derivatives.Func, float64, float64) (float64, error)
+
+func (m methodFunc) Calculate(ctx context.Context, f derivatives.Func, x, h float64) (float64, error) {
+	return m(ctx, f, x, h)
+}
+
+func TestBatch_matchesSerial(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	xs := batchGrid(500)
+	method := third.NewCentral(4)
+
+	got, err := derivatives.Batch(ctx, method, math.Sin, xs, 1e-2, derivatives.WithWorkers(8))
+	require.NoError(t, err)
+	require.Len(t, got, len(xs))
+
+	for i, x := range xs {
+		expected, err := method.Calculate(ctx, math.Sin, x, 1e-2)
+		require.NoError(t, err)
+		assert.Equal(t, expected, got[i])
+	}
+}
+
+func TestBatch_boundedWorkers(t *testing.T) {
+	t.Parallel()
+
+	var running, peak atomic.Int64
+	method := methodFunc(func(ctx context.Context, f derivatives.Func, x, h float64) (float64, error) {
+		n := running.Add(1)
+		defer running.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		return first.NewCentral(2).Calculate(ctx, f, x, h)
+	})
+
+	_, err := derivatives.Batch(context.Background(), method, math.Exp, batchGrid(64), 1e-3,
+		derivatives.WithWorkers(3))
+	require.NoError(t, err)
+	assert.LessOrEqual(t, peak.Load(), int64(3))
+	assert.Positive(t, peak.Load())
+}
+
+func TestBatch_cancellation(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var calls atomic.Int64
+	slow := func(x float64) float64 {
+		if calls.Add(1) == 10 {
+			cancel()
+		}
+		time.Sleep(time.Millisecond)
+		return x * x
+	}
+
+	start := time.Now()
+	got, err := derivatives.Batch(ctx, first.NewCentral(2), slow, batchGrid(10_000), batchStep,
+		derivatives.WithWorkers(4))
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Nil(t, got)
+
+	// Sem o cancelamento seriam 20 000 avaliações de 1 ms em 4 workers.
+	assert.Less(t, time.Since(start), time.Second)
+	assert.Less(t, calls.Load(), int64(100))
+}
+
+func TestBatch_methodError(t *testing.T) {
+	t.Parallel()
+
+	errBoom := errors.New("falha na fórmula")
+	method := methodFunc(func(ctx context.Context, f derivatives.Func, x, h float64) (float64, error) {
+		if x == 10*batchStep {
+			return 0, errBoom
+		}
+		return f(x), ctx.Err()
+	})
+
+	_, err := derivatives.Batch(context.Background(), method, math.Exp, batchGrid(100), batchStep)
+	require.ErrorIs(t, err, errBoom)
+}
+
+func TestBatch_memo(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	xs := batchGrid(200)
+
+	var evaluations atomic.Int64
+	f := func(x float64) float64 {
+		evaluations.Add(1)
+		return math.Exp(math.Sin(x))
+	}
+
+	// A central O(h⁴) avalia x ± h e x ± 2h; com h igual ao espaçamento das abscissas,
+	// todos esses pontos são compartilhados com abscissas vizinhas.
+	memo := derivatives.NewMemo(f)
+	got, err := derivatives.Batch(ctx, first.NewCentral(4), f, xs, batchStep,
+		derivatives.WithWorkers(4), derivatives.WithMemo(memo))
+	require.NoError(t, err)
+
+	hits, misses := memo.Stats()
+	assert.Equal(t, int64(len(xs)+4), misses)
+	assert.Equal(t, misses, evaluations.Load())
+	assert.Equal(t, int64(4*len(xs))-misses, hits)
+
+	// O cache não muda os resultados: sem ele, cada derivada avalia f de novo.
+	plain, err := derivatives.Batch(ctx, first.NewCentral(4), f, xs, batchStep, derivatives.WithWorkers(4))
+	require.NoError(t, err)
+	require.Len(t, plain, len(got))
+	for i := range xs {
+		assert.Equalf(t, plain[i], got[i], "abscissa %d", i)
+	}
+}
+
+func TestBatch_invalidWorkers(t *testing.T) {
+	t.Parallel()
+
+	_, err := derivatives.Batch(context.Background(), first.NewCentral(2), math.Sin, batchGrid(3), 1e-3,
+		derivatives.WithWorkers(0))
+	require.Error(t, err)
+}
@@ -0,0 +1,87 @@
+package derivatives_test
+
+import (
+	"context"
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives"
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/autodiff"
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/first"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// g(x) = eˣ / sqrt(sin³x + cos³x), a função de teste clássica de Squire e Trapp.
+func squireTrapp(z complex128) complex128 {
+	s, c := cmplx.Sin(z), cmplx.Cos(z)
+	return cmplx.Exp(z) / cmplx.Sqrt(s*s*s+c*c*c)
+}
+
+// squireTrappDual é a mesma g em números duais, para obter g'(x) exata.
+func squireTrappDual(x autodiff.Dual) autodiff.Dual {
+	s, c := x.Sin(), x.Cos()
+	return x.Exp().Div(s.Mul(s).Mul(s).Add(c.Mul(c).Mul(c)).Sqrt())
+}
+
+func TestDerivatives_complexStep(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	x := 1.5
+	expected := autodiff.Derivative(squireTrappDual, x)
+
+	f := derivatives.ComplexFunc(squireTrapp)
+	complexStep := first.NewComplexStep(f)
+	central := first.NewCentral(4)
+
+	var previousCentralErr float64
+	for _, h := range []float64{1e-2, 1e-4, 1e-6, 1e-8, 1e-10, 1e-12} {
+		gotComplex, err := complexStep.Calculate(ctx, f.Real(), x, h)
+		require.NoError(t, err)
+		gotCentral, err := central.Calculate(ctx, f.Real(), x, h)
+		require.NoError(t, err)
+
+		complexErr := math.Abs(gotComplex - expected)
+		centralErr := math.Abs(gotCentral - expected)
+		t.Logf("h=%.0e  erro passo complexo=%.3e  erro central O(h⁴)=%.3e", h, complexErr, centralErr)
+
+		if h <= 1e-6 {
+			// Abaixo do passo ótimo a fórmula central é dominada pelo arredondamento.
+			assert.Lessf(t, complexErr, centralErr, "h=%g", h)
+		}
+		if h <= 1e-8 {
+			// Sem cancelamento, o passo complexo chega à precisão de máquina.
+			assert.Lessf(t, complexErr, 1e-13, "passo complexo com h=%g", h)
+			assert.Greaterf(t, centralErr, previousCentralErr, "erro central deveria crescer com h=%g", h)
+		}
+		previousCentralErr = centralErr
+	}
+
+	got, err := complexStep.Calculate(ctx, f.Real(), x, derivatives.AutoStep)
+	require.NoError(t, err)
+	assert.InDelta(t, expected, got, 1e-13)
+}
+
+func TestDerivatives_complexStepMismatch(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	complexStep := first.NewComplexStep(cmplx.Exp)
+
+	// A mesma função com implementação real é aceita.
+	got, err := complexStep.Calculate(ctx, math.Exp, 1, 1e-20)
+	require.NoError(t, err)
+	assert.InDelta(t, math.E, got, 1e-15)
+
+	// Outra função seria ignorada em silêncio.
+	_, err = complexStep.Calculate(ctx, math.Sin, 1, 1e-20)
+	assert.ErrorIs(t, err, first.ErrFunctionMismatch)
+
+	_, err = complexStep.Calculate(ctx, nil, 1, 1e-20)
+	assert.ErrorIs(t, err, first.ErrFunctionMismatch)
+
+	_, err = complexStep.Calculate(ctx, math.Exp, 1, 0)
+	assert.ErrorIs(t, err, derivatives.ErrInvalidStep)
+}
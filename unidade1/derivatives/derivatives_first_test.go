@@ -3,10 +3,12 @@ package derivatives_test
 import (
 	"context"
 	"log/slog"
+	"math"
 	"os"
 	"testing"
 
 	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives"
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/autodiff"
 	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/first"
 	"github.com/stretchr/testify/assert"
 )
@@ -16,9 +18,15 @@ func cubicFunc(xi float64) float64 {
 	return xi * xi * xi * xi
 }
 
-// f'(x) = 4x^3
-func cubicFuncD1(xi float64) float64 {
-	return 4.0 * xi * xi * xi
+// cubicTaylor é a mesma f(x) = x^4 em aritmética de Taylor.
+func cubicTaylor(xi autodiff.Taylor) autodiff.Taylor {
+	return xi.Mul(xi).Mul(xi).Mul(xi)
+}
+
+// exactDerivative retorna a derivada de ordem n de f(x) = x^4, calculada por
+// diferenciação automática.
+func exactDerivative(n int, xi float64) float64 {
+	return autodiff.NthDerivative(cubicTaylor, xi, n)
 }
 
 func TestDerivatives_first_order1(t *testing.T) {
@@ -40,23 +48,23 @@ func TestDerivatives_first_order1(t *testing.T) {
 
 	tests := []struct {
 		name             string
-		derivativeMethod derivatives.DerivativeInterface
+		derivativeMethod derivatives.EstimatorInterface
 		expected         float64
 	}{
 		{
 			name:             "FirstForwardO1",
 			derivativeMethod: first.NewForward(order),
-			expected:         cubicFuncD1(x),
+			expected:         exactDerivative(1, x),
 		},
 		{
 			name:             "FirstBackwardO1",
 			derivativeMethod: first.NewBackward(order),
-			expected:         cubicFuncD1(x),
+			expected:         exactDerivative(1, x),
 		},
 		{
 			name:             "FirstCentralO1",
 			derivativeMethod: first.NewCentral(order),
-			expected:         cubicFuncD1(x),
+			expected:         exactDerivative(1, x),
 		},
 	}
 
@@ -66,16 +74,21 @@ func TestDerivatives_first_order1(t *testing.T) {
 			// Executa a função de derivada que está sendo testada
 			ctx := context.Background()
 			method := tc.derivativeMethod
-			got, err := method.Calculate(ctx, cubicFunc, x, h)
+			got, err := method.Estimate(ctx, cubicFunc, x, h)
 			assert.NoError(t, err)
 
 			assert.InDeltaf(t,
 				tc.expected,
-				got,
+				got.Value,
 				tolerance,
 				"Função %s falhou: esperado %.8f, obtido %.8f",
-				tc.name, tc.expected, got,
+				tc.name, tc.expected, got.Value,
 			)
+
+			// A cota reportada pela fórmula deve cobrir o erro real.
+			assert.LessOrEqualf(t, math.Abs(got.Value-tc.expected), got.Bound(),
+				"Função %s: erro %.2e acima da cota %.2e",
+				tc.name, math.Abs(got.Value-tc.expected), got.Bound())
 		})
 	}
 }
@@ -94,29 +107,28 @@ func TestDerivatives_first_order2(t *testing.T) {
 	h := 1e-3
 	order := uint64(2)
 
-	// tolerancia alta pq sao ruins
 	tolerance := 1e-3
 
 	tests := []struct {
 		name             string
-		derivativeMethod derivatives.DerivativeInterface
+		derivativeMethod derivatives.EstimatorInterface
 		expected         float64
 	}{
 		{
 			name:             "FirstForwardO2",
 			derivativeMethod: first.NewForward(order),
 
-			expected: cubicFuncD1(x),
+			expected: exactDerivative(1, x),
 		},
 		{
 			name:             "FirstBackwardO2",
 			derivativeMethod: first.NewBackward(order),
-			expected:         cubicFuncD1(x),
+			expected:         exactDerivative(1, x),
 		},
 		{
 			name:             "FirstCentralO2",
 			derivativeMethod: first.NewCentral(order),
-			expected:         cubicFuncD1(x),
+			expected:         exactDerivative(1, x),
 		},
 	}
 
@@ -126,16 +138,21 @@ func TestDerivatives_first_order2(t *testing.T) {
 			// Executa a função de derivada que está sendo testada
 			ctx := context.Background()
 			method := tc.derivativeMethod
-			got, err := method.Calculate(ctx, cubicFunc, x, h)
+			got, err := method.Estimate(ctx, cubicFunc, x, h)
 			assert.NoError(t, err)
 
 			assert.InDeltaf(t,
 				tc.expected,
-				got,
+				got.Value,
 				tolerance,
 				"Função %s falhou: esperado %.8f, obtido %.8f",
-				tc.name, tc.expected, got,
+				tc.name, tc.expected, got.Value,
 			)
+
+			// A cota reportada pela fórmula deve cobrir o erro real.
+			assert.LessOrEqualf(t, math.Abs(got.Value-tc.expected), got.Bound(),
+				"Função %s: erro %.2e acima da cota %.2e",
+				tc.name, math.Abs(got.Value-tc.expected), got.Bound())
 		})
 	}
 }
@@ -154,28 +171,27 @@ func TestDerivatives_first_order3(t *testing.T) {
 	h := 1e-3
 	order := uint64(3)
 
-	// tolerancia alta pq sao ruins
 	tolerance := 1e-4
 
 	tests := []struct {
 		name             string
-		derivativeMethod derivatives.DerivativeInterface
+		derivativeMethod derivatives.EstimatorInterface
 		expected         float64
 	}{
 		{
 			name:             "FirstForwardO3",
 			derivativeMethod: first.NewForward(order),
-			expected:         cubicFuncD1(x),
+			expected:         exactDerivative(1, x),
 		},
 		{
 			name:             "FirstBackwardO3",
 			derivativeMethod: first.NewBackward(order),
-			expected:         cubicFuncD1(x),
+			expected:         exactDerivative(1, x),
 		},
 		{
 			name:             "FirstCentralO3",
 			derivativeMethod: first.NewCentral(order),
-			expected:         cubicFuncD1(x),
+			expected:         exactDerivative(1, x),
 		},
 	}
 
@@ -185,17 +201,22 @@ func TestDerivatives_first_order3(t *testing.T) {
 			// Executa a função de derivada que está sendo testada
 			ctx := context.Background()
 			method := tc.derivativeMethod
-			got, err := method.Calculate(ctx, cubicFunc, x, h)
+			got, err := method.Estimate(ctx, cubicFunc, x, h)
 
 			assert.NoError(t, err, "Erro ao calcular a derivada: %v", err)
 
 			assert.InDeltaf(t,
 				tc.expected,
-				got,
+				got.Value,
 				tolerance,
 				"Função %s falhou: esperado %.8f, obtido %.8f",
-				tc.name, tc.expected, got,
+				tc.name, tc.expected, got.Value,
 			)
+
+			// A cota reportada pela fórmula deve cobrir o erro real.
+			assert.LessOrEqualf(t, math.Abs(got.Value-tc.expected), got.Bound(),
+				"Função %s: erro %.2e acima da cota %.2e",
+				tc.name, math.Abs(got.Value-tc.expected), got.Bound())
 		})
 	}
 }
@@ -214,28 +235,27 @@ func TestDerivatives_first_order4(t *testing.T) {
 	h := 1e-3
 	order := uint64(4)
 
-	// tolerancia alta pq sao ruins
 	tolerance := 1e-5
 
 	tests := []struct {
 		name             string
-		derivativeMethod derivatives.DerivativeInterface
+		derivativeMethod derivatives.EstimatorInterface
 		expected         float64
 	}{
 		{
 			name:             "FirstForwardO4",
 			derivativeMethod: first.NewForward(order),
-			expected:         cubicFuncD1(x),
+			expected:         exactDerivative(1, x),
 		},
 		{
 			name:             "FirstBackwardO4",
 			derivativeMethod: first.NewBackward(order),
-			expected:         cubicFuncD1(x),
+			expected:         exactDerivative(1, x),
 		},
 		{
 			name:             "FirstCentralO3",
 			derivativeMethod: first.NewCentral(order),
-			expected:         cubicFuncD1(x),
+			expected:         exactDerivative(1, x),
 		},
 	}
 
@@ -245,17 +265,22 @@ func TestDerivatives_first_order4(t *testing.T) {
 			// Executa a função de derivada que está sendo testada
 			ctx := context.Background()
 			method := tc.derivativeMethod
-			got, err := method.Calculate(ctx, cubicFunc, x, h)
+			got, err := method.Estimate(ctx, cubicFunc, x, h)
 
 			assert.NoError(t, err, "Erro ao calcular a derivada: %v", err)
 
 			assert.InDeltaf(t,
 				tc.expected,
-				got,
+				got.Value,
 				tolerance,
 				"Função %s falhou: esperado %.8f, obtido %.8f",
-				tc.name, tc.expected, got,
+				tc.name, tc.expected, got.Value,
 			)
+
+			// A cota reportada pela fórmula deve cobrir o erro real.
+			assert.LessOrEqualf(t, math.Abs(got.Value-tc.expected), got.Bound(),
+				"Função %s: erro %.2e acima da cota %.2e",
+				tc.name, math.Abs(got.Value-tc.expected), got.Bound())
 		})
 	}
 }
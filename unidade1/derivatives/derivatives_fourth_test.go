@@ -0,0 +1,252 @@
+package derivatives_test
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives"
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/fourth"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDerivatives_fourth_order1(t *testing.T) {
+	// arrange log
+	t.Parallel()
+	opts := &slog.HandlerOptions{
+		Level: slog.LevelInfo,
+	}
+	handler := slog.NewJSONHandler(os.Stdout, opts)
+	logger := slog.New(handler)
+	slog.SetDefault(logger)
+
+	h := 1e-2
+	order := uint64(1)
+
+	tolerance := 1e-3
+
+	tests := []struct {
+		name             string
+		derivativeMethod derivatives.DerivativeInterface
+		expected         float64
+	}{
+		{
+			name:             "fourthForwardO1",
+			derivativeMethod: fourth.NewForward(order),
+			expected:         exactDerivative(4, x),
+		},
+		{
+			name:             "fourthBackwardO1",
+			derivativeMethod: fourth.NewBackward(order),
+			expected:         exactDerivative(4, x),
+		},
+		{
+			name:             "fourthCentralO1",
+			derivativeMethod: fourth.NewCentral(order),
+			expected:         exactDerivative(4, x),
+		},
+	}
+
+	// Loop através da tabela de casos de teste
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Executa a função de derivada que está sendo testada
+			ctx := context.Background()
+			method := tc.derivativeMethod
+			got, err := method.Calculate(ctx, cubicFunc, x, h)
+
+			if !assert.NoError(t, err, "Erro ao calcular a derivada: %v", err) {
+				t.SkipNow()
+			}
+
+			assert.InDeltaf(t,
+				tc.expected,
+				got,
+				tolerance,
+				"Função %s falhou: esperado %.8f, obtido %.8f",
+				tc.name, tc.expected, got,
+			)
+		})
+	}
+}
+
+func TestDerivatives_fourth_order2(t *testing.T) {
+	// arrange log
+	t.Parallel()
+	opts := &slog.HandlerOptions{
+		Level: slog.LevelInfo,
+	}
+	handler := slog.NewJSONHandler(os.Stdout, opts)
+	logger := slog.New(handler)
+	slog.SetDefault(logger)
+
+	h := 1e-2
+	order := uint64(2)
+
+	tolerance := 1e-3
+
+	tests := []struct {
+		name             string
+		derivativeMethod derivatives.DerivativeInterface
+		expected         float64
+	}{
+		{
+			name:             "fourthForwardO2",
+			derivativeMethod: fourth.NewForward(order),
+			expected:         exactDerivative(4, x),
+		},
+		{
+			name:             "fourthBackwardO2",
+			derivativeMethod: fourth.NewBackward(order),
+			expected:         exactDerivative(4, x),
+		},
+		{
+			name:             "fourthCentralO2",
+			derivativeMethod: fourth.NewCentral(order),
+			expected:         exactDerivative(4, x),
+		},
+	}
+
+	// Loop através da tabela de casos de teste
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Executa a função de derivada que está sendo testada
+			ctx := context.Background()
+			method := tc.derivativeMethod
+			got, err := method.Calculate(ctx, cubicFunc, x, h)
+
+			if !assert.NoError(t, err, "Erro ao calcular a derivada: %v", err) {
+				t.SkipNow()
+			}
+
+			assert.InDeltaf(t,
+				tc.expected,
+				got,
+				tolerance,
+				"Função %s falhou: esperado %.8f, obtido %.8f",
+				tc.name, tc.expected, got,
+			)
+		})
+	}
+}
+
+func TestDerivatives_fourth_order3(t *testing.T) {
+	// arrange log
+	t.Parallel()
+	opts := &slog.HandlerOptions{
+		Level: slog.LevelInfo,
+	}
+	handler := slog.NewJSONHandler(os.Stdout, opts)
+	logger := slog.New(handler)
+	slog.SetDefault(logger)
+
+	h := 1e-2
+	order := uint64(3)
+
+	tolerance := 1e-3
+
+	tests := []struct {
+		name             string
+		derivativeMethod derivatives.DerivativeInterface
+		expected         float64
+	}{
+		{
+			name:             "fourthForwardO3",
+			derivativeMethod: fourth.NewForward(order),
+			expected:         exactDerivative(4, x),
+		},
+		{
+			name:             "fourthBackwardO3",
+			derivativeMethod: fourth.NewBackward(order),
+			expected:         exactDerivative(4, x),
+		},
+		{
+			name:             "fourthCentralO3",
+			derivativeMethod: fourth.NewCentral(order),
+			expected:         exactDerivative(4, x),
+		},
+	}
+
+	// Loop através da tabela de casos de teste
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Executa a função de derivada que está sendo testada
+			ctx := context.Background()
+			method := tc.derivativeMethod
+			got, err := method.Calculate(ctx, cubicFunc, x, h)
+
+			if !assert.NoError(t, err, "Erro ao calcular a derivada: %v", err) {
+				t.SkipNow()
+			}
+
+			assert.InDeltaf(t,
+				tc.expected,
+				got,
+				tolerance,
+				"Função %s falhou: esperado %.8f, obtido %.8f",
+				tc.name, tc.expected, got,
+			)
+		})
+	}
+}
+
+func TestDerivatives_fourth_order4(t *testing.T) {
+	// arrange log
+	t.Parallel()
+	opts := &slog.HandlerOptions{
+		Level: slog.LevelInfo,
+	}
+	handler := slog.NewJSONHandler(os.Stdout, opts)
+	logger := slog.New(handler)
+	slog.SetDefault(logger)
+
+	h := 1e-2
+	order := uint64(4)
+
+	tolerance := 1e-3
+
+	tests := []struct {
+		name             string
+		derivativeMethod derivatives.DerivativeInterface
+		expected         float64
+	}{
+		{
+			name:             "fourthForwardO4",
+			derivativeMethod: fourth.NewForward(order),
+			expected:         exactDerivative(4, x),
+		},
+		{
+			name:             "fourthBackwardO4",
+			derivativeMethod: fourth.NewBackward(order),
+			expected:         exactDerivative(4, x),
+		},
+		{
+			name:             "fourthCentralO4",
+			derivativeMethod: fourth.NewCentral(order),
+			expected:         exactDerivative(4, x),
+		},
+	}
+
+	// Loop através da tabela de casos de teste
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Executa a função de derivada que está sendo testada
+			ctx := context.Background()
+			method := tc.derivativeMethod
+			got, err := method.Calculate(ctx, cubicFunc, x, h)
+
+			if !assert.NoError(t, err, "Erro ao calcular a derivada: %v", err) {
+				t.SkipNow()
+			}
+
+			assert.InDeltaf(t,
+				tc.expected,
+				got,
+				tolerance,
+				"Função %s falhou: esperado %.8f, obtido %.8f",
+				tc.name, tc.expected, got,
+			)
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package derivatives_test
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives"
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/first"
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/second"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRichardson_extrapolate(t *testing.T) {
+	t.Parallel()
+
+	x := 0.5
+	h := 0.1
+
+	tests := []struct {
+		name       string
+		richardson *derivatives.Richardson
+		expected   float64
+		tolerance  float64
+	}{
+		{
+			name:       "FirstForwardO1",
+			richardson: derivatives.NewRichardson(first.NewForward(1), 1, 1e-10),
+			expected:   math.Cos(x),
+			tolerance:  1e-10,
+		},
+		{
+			name:       "FirstBackwardO2",
+			richardson: derivatives.NewRichardson(first.NewBackward(2), 2, 1e-10),
+			expected:   math.Cos(x),
+			tolerance:  1e-10,
+		},
+		{
+			name: "FirstCentralO2",
+			richardson: derivatives.NewRichardson(first.NewCentral(2), 2, 1e-12,
+				derivatives.WithExponentStep(2)),
+			expected:  math.Cos(x),
+			tolerance: 1e-12,
+		},
+		{
+			name: "SecondCentralO1",
+			richardson: derivatives.NewRichardson(second.NewCentral(1), 2, 1e-8,
+				derivatives.WithExponentStep(2)),
+			expected:  -math.Sin(x),
+			tolerance: 1e-8,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tc.richardson.Extrapolate(context.Background(), math.Sin, x, h)
+			require.NoError(t, err)
+
+			assert.InDeltaf(t, tc.expected, got.Value, tc.tolerance,
+				"Função %s falhou: esperado %.12f, obtido %.12f", tc.name, tc.expected, got.Value)
+			assert.LessOrEqual(t, got.ErrorEstimate, tc.tolerance)
+			assert.GreaterOrEqual(t, got.Levels, 2)
+		})
+	}
+}
+
+func TestRichardson_toleranceNotReached(t *testing.T) {
+	t.Parallel()
+
+	r := derivatives.NewRichardson(first.NewForward(1), 1, 1e-30, derivatives.WithMaxLevels(4))
+	got, err := r.Extrapolate(context.Background(), math.Exp, 1, 0.1)
+
+	assert.ErrorIs(t, err, derivatives.ErrToleranceNotReached)
+	assert.LessOrEqual(t, got.Levels, 4)
+	assert.InDelta(t, math.E, got.Value, 1e-4)
+}
+
+func TestRichardson_contextCanceled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	r := derivatives.NewRichardson(first.NewCentral(4), 4, 1e-12)
+	_, err := r.Calculate(ctx, math.Exp, 1, 0.1)
+
+	assert.ErrorIs(t, err, context.Canceled)
+}
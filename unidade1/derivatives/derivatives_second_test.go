@@ -11,11 +11,6 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
-// f”(x) = 12x^2
-func cubicFuncD2(xi float64) float64 {
-	return 12.0 * xi * xi
-}
-
 func TestDerivatives_second_order1(t *testing.T) {
 	// arrange log
 	t.Parallel()
@@ -41,17 +36,17 @@ func TestDerivatives_second_order1(t *testing.T) {
 		{
 			name:             "SecondForwardO1",
 			derivativeMethod: second.NewForward(order),
-			expected:         cubicFuncD2(x),
+			expected:         exactDerivative(2, x),
 		},
 		{
 			name:             "SecondBackwardO1",
 			derivativeMethod: second.NewBackward(order),
-			expected:         cubicFuncD2(x),
+			expected:         exactDerivative(2, x),
 		},
 		{
 			name:             "SecondCentralO1",
 			derivativeMethod: second.NewCentral(order),
-			expected:         cubicFuncD2(x),
+			expected:         exactDerivative(2, x),
 		},
 	}
 
@@ -100,17 +95,17 @@ func TestDerivatives_second_order2(t *testing.T) {
 		{
 			name:             "SecondForwardO2",
 			derivativeMethod: second.NewForward(order),
-			expected:         cubicFuncD2(x),
+			expected:         exactDerivative(2, x),
 		},
 		{
 			name:             "SecondBackwardO2",
 			derivativeMethod: second.NewBackward(order),
-			expected:         cubicFuncD2(x),
+			expected:         exactDerivative(2, x),
 		},
 		{
 			name:             "SecondCentralO2",
 			derivativeMethod: second.NewCentral(order),
-			expected:         cubicFuncD2(x),
+			expected:         exactDerivative(2, x),
 		},
 	}
 
@@ -159,17 +154,17 @@ func TestDerivatives_second_order3(t *testing.T) {
 		{
 			name:             "SecondForwardO3",
 			derivativeMethod: second.NewForward(order),
-			expected:         cubicFuncD2(x),
+			expected:         exactDerivative(2, x),
 		},
 		{
 			name:             "SecondBackwardO3",
 			derivativeMethod: second.NewBackward(order),
-			expected:         cubicFuncD2(x),
+			expected:         exactDerivative(2, x),
 		},
 		{
 			name:             "SecondCentralO3",
 			derivativeMethod: second.NewCentral(order),
-			expected:         cubicFuncD2(x),
+			expected:         exactDerivative(2, x),
 		},
 	}
 
@@ -218,17 +213,17 @@ func TestDerivatives_second_order4(t *testing.T) {
 		{
 			name:             "SecondForwardO4",
 			derivativeMethod: second.NewForward(order),
-			expected:         cubicFuncD2(x),
+			expected:         exactDerivative(2, x),
 		},
 		{
 			name:             "SecondBackwardO4",
 			derivativeMethod: second.NewBackward(order),
-			expected:         cubicFuncD2(x),
+			expected:         exactDerivative(2, x),
 		},
 		{
 			name:             "SecondCentralO4",
 			derivativeMethod: second.NewCentral(order),
-			expected:         cubicFuncD2(x),
+			expected:         exactDerivative(2, x),
 		},
 	}
 
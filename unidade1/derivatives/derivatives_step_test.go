@@ -0,0 +1,125 @@
+package derivatives_test
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives"
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/first"
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/second"
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/third"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptimalStep(t *testing.T) {
+	t.Parallel()
+
+	eps := math.Nextafter(1, 2) - 1
+
+	// Fórmula O(h²) da primeira derivada: h* ≈ (ε/2)^(1/3).
+	h := derivatives.OptimalStep(1, 2, 1, 1)
+	assert.InEpsilon(t, math.Cbrt(eps/2), h, 1e-6)
+
+	// O passo acompanha a escala de x.
+	assert.InEpsilon(t, 1e3*h, derivatives.OptimalStep(1, 2, 1e3, 1), 1e-6)
+
+	// Derivadas de ordem maior precisam de passos maiores.
+	assert.Greater(t, derivatives.OptimalStep(3, 2, 1, 1), derivatives.OptimalStep(1, 2, 1, 1))
+
+	assert.Panics(t, func() { derivatives.OptimalStep(1, 0, 1, 1) })
+}
+
+func TestAutoStep(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	x := 1.2
+
+	tests := []struct {
+		name             string
+		derivativeMethod derivatives.DerivativeInterface
+		expected         float64
+		tolerance        float64
+	}{
+		{"FirstForwardO1", first.NewForward(1), math.Exp(x), 1e-7},
+		{"FirstCentralO4", first.NewCentral(4), math.Exp(x), 1e-12},
+		{"SecondBackwardO2", second.NewBackward(2), math.Exp(x), 1e-5},
+		{"SecondCentralO1", second.NewCentral(1), math.Exp(x), 1e-5},
+		{"ThirdForwardO1", third.NewForward(1), math.Exp(x), 1e-2},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tc.derivativeMethod.Calculate(ctx, math.Exp, x, derivatives.AutoStep)
+			require.NoError(t, err)
+
+			assert.InDeltaf(t, tc.expected, got, tc.tolerance,
+				"Função %s falhou: esperado %.10f, obtido %.10f", tc.name, tc.expected, got)
+		})
+	}
+}
+
+func TestRefineStep(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	method := first.NewCentral(2)
+	x := 1.0
+
+	// Passo inicial grande demais: o erro de truncamento domina.
+	h0 := 0.2
+	h, err := derivatives.RefineStep(ctx, method, math.Exp, x, h0, 6)
+	require.NoError(t, err)
+	assert.Less(t, h, h0)
+
+	before, _ := method.Calculate(ctx, math.Exp, x, h0)
+	after, _ := method.Calculate(ctx, math.Exp, x, h)
+	assert.Less(t, math.Abs(after-math.E), math.Abs(before-math.E))
+
+	_, err = derivatives.RefineStep(ctx, method, math.Exp, x, -1, 3)
+	assert.Error(t, err)
+}
+
+func TestResolveStep(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	method := first.NewCentral(2)
+
+	h, err := derivatives.ResolveStep(ctx, method, math.Exp, 1, 0.1, 1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 0.1, h)
+
+	// O modo automático parte de OptimalStep e fica a no máximo 2² vezes dele.
+	h0 := derivatives.OptimalStep(1, 2, 1, 1)
+	h, err = derivatives.ResolveStep(ctx, method, math.Exp, 1, derivatives.AutoStep, 1, 2)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, h, h0/4)
+	assert.LessOrEqual(t, h, 4*h0)
+
+	// Um passo negativo qualquer, mesmo -1, é usado como foi informado.
+	h, err = derivatives.ResolveStep(ctx, method, math.Exp, 1, -1, 1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, -1.0, h)
+
+	var evaluated []float64
+	square := func(x float64) float64 {
+		evaluated = append(evaluated, x)
+		return x * x
+	}
+	got, err := first.NewForward(1).Calculate(ctx, square, 0, -1)
+	require.NoError(t, err)
+	assert.Equal(t, -1.0, got, "(f(-1) - f(0)) / (-1)")
+	assert.ElementsMatch(t, []float64{-1, 0}, evaluated)
+
+	// Um passo nulo é um erro, e não o modo automático.
+	for _, h := range []float64{0, math.NaN()} {
+		_, err = derivatives.ResolveStep(ctx, method, math.Exp, 1, h, 1, 2)
+		assert.ErrorIs(t, err, derivatives.ErrInvalidStep)
+
+		_, err = method.Calculate(ctx, math.Exp, 1, h)
+		assert.ErrorIs(t, err, derivatives.ErrInvalidStep)
+	}
+}
@@ -13,11 +13,6 @@ import (
 
 var x = float64(2)
 
-// f”'(x) = 24x
-func cubicFuncD3(xi float64) float64 {
-	return 24.0 * xi
-}
-
 func TestDerivatives_third_order1(t *testing.T) {
 	// arrange log
 	t.Parallel()
@@ -42,17 +37,17 @@ func TestDerivatives_third_order1(t *testing.T) {
 		{
 			name:             "thirdForwardO1",
 			derivativeMethod: third.NewForward(order),
-			expected:         cubicFuncD3(x),
+			expected:         exactDerivative(3, x),
 		},
 		{
 			name:             "thirdBackwardO1",
 			derivativeMethod: third.NewBackward(order),
-			expected:         cubicFuncD3(x),
+			expected:         exactDerivative(3, x),
 		},
 		{
 			name:             "thirdCentralO1",
 			derivativeMethod: third.NewCentral(order),
-			expected:         cubicFuncD3(x),
+			expected:         exactDerivative(3, x),
 		},
 	}
 
@@ -103,17 +98,17 @@ func TestDerivatives_third_order2(t *testing.T) {
 		{
 			name:             "thirdForwardO2",
 			derivativeMethod: third.NewForward(order),
-			expected:         cubicFuncD3(x),
+			expected:         exactDerivative(3, x),
 		},
 		{
 			name:             "thirdBackwardO2",
 			derivativeMethod: third.NewBackward(order),
-			expected:         cubicFuncD3(x),
+			expected:         exactDerivative(3, x),
 		},
 		{
 			name:             "thirdCentralO2",
 			derivativeMethod: third.NewCentral(order),
-			expected:         cubicFuncD3(x),
+			expected:         exactDerivative(3, x),
 		},
 	}
 
@@ -163,17 +158,17 @@ func TestDerivatives_third_order3(t *testing.T) {
 		{
 			name:             "thirdForwardO3",
 			derivativeMethod: third.NewForward(order),
-			expected:         cubicFuncD3(x),
+			expected:         exactDerivative(3, x),
 		},
 		{
 			name:             "thirdBackwardO3",
 			derivativeMethod: third.NewBackward(order),
-			expected:         cubicFuncD3(x),
+			expected:         exactDerivative(3, x),
 		},
 		{
 			name:             "thirdCentralO3",
 			derivativeMethod: third.NewCentral(order),
-			expected:         cubicFuncD3(x),
+			expected:         exactDerivative(3, x),
 		},
 	}
 
@@ -224,17 +219,17 @@ func TestDerivatives_third_order4(t *testing.T) {
 		{
 			name:             "thirdForwardO4",
 			derivativeMethod: third.NewForward(order),
-			expected:         cubicFuncD3(x),
+			expected:         exactDerivative(3, x),
 		},
 		{
 			name:             "thirdBackwardO4",
 			derivativeMethod: third.NewBackward(order),
-			expected:         cubicFuncD3(x),
+			expected:         exactDerivative(3, x),
 		},
 		{
 			name:             "thirdCentralO4",
 			derivativeMethod: third.NewCentral(order),
-			expected:         cubicFuncD3(x),
+			expected:         exactDerivative(3, x),
 		},
 	}
 
@@ -6,14 +6,24 @@ import (
 	"log/slog"
 
 	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives"
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/stencil"
 )
 
-var _ derivatives.DerivativeInterface = (*Central)(nil)
+var (
+	_ derivatives.EstimatorInterface = (*Central)(nil)
+	_ stencil.Describer              = (*Central)(nil)
+)
 
 // Central é uma struct que calcula a primeira derivada pela filosofia progressiva.
 type Central struct {
 	// formula armazena a função de cálculo específica (com base na ordem de erro).
 	formula func(ctx context.Context, f derivatives.Func, x, h float64) float64
+	// errorOrder é a ordem de erro da fórmula, usada no modo de passo automático.
+	errorOrder uint64
+	// reference é a fórmula gerada de ordem de erro maior, usada para estimar o erro.
+	reference *stencil.Stencil
+	// generated é a fórmula gerada com os mesmos coeficientes de formula, usada na introspecção.
+	generated *stencil.Stencil
 }
 
 func NewCentral(errorOrder uint64) *Central {
@@ -33,16 +43,44 @@ func NewCentral(errorOrder uint64) *Central {
 	}
 
 	return &Central{
-		formula: selectedFormula,
+		formula:    selectedFormula,
+		errorOrder: errorOrder,
+		reference:  stencil.NewCentral(1, errorOrder+2),
+		generated:  stencil.NewCentral(1, errorOrder),
 	}
 }
 
 // Calculate executa o cálculo da derivada usando a fórmula que foi definida no Newcentral.
 func (b *Central) Calculate(ctx context.Context, f derivatives.Func, x, h float64) (float64, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	h, err := derivatives.ResolveStep(ctx, b, f, x, h, 1, b.errorOrder)
+	if err != nil {
+		return 0, err
+	}
+
 	// A mágica acontece aqui: chamamos a fórmula que foi "injetada".
 	return b.formula(ctx, f, x, h), nil
 }
 
+// Estimate calcula a derivada e estima o erro de truncamento pela diferença para a
+// fórmula gerada com ordem de erro duas unidades maior.
+func (b *Central) Estimate(ctx context.Context, f derivatives.Func, x, h float64) (derivatives.Result, error) {
+	h, err := derivatives.ResolveStep(ctx, b, f, x, h, 1, b.errorOrder)
+	if err != nil {
+		return derivatives.Result{}, err
+	}
+
+	return stencil.Estimate(ctx, b, b.reference, f, x, h)
+}
+
+// Describe retorna os deslocamentos, coeficientes, divisor e termo dominante do erro da fórmula.
+func (b *Central) Describe() stencil.Description {
+	return b.generated.Describe()
+}
+
 // centralOrder1 implementa a fórmula regressiva com erro O(h).
 // central euler method
 func centralOrder1(ctx context.Context, f derivatives.Func, x, h float64) float64 {
@@ -0,0 +1,75 @@
+package first
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives"
+)
+
+var _ derivatives.DerivativeInterface = (*ComplexStep)(nil)
+
+// ErrFunctionMismatch indica que a função passada a ComplexStep.Calculate não é a restrição
+// aos reais da extensão complexa informada em NewComplexStep.
+var ErrFunctionMismatch = errors.New("first: função diferente da extensão complexa do passo complexo")
+
+// mismatchTolerance é a diferença relativa máxima entre f(x) e Re(F(x)) aceita por
+// ComplexStep.Calculate, folgada o bastante para implementações reais e complexas distintas
+// da mesma função.
+const mismatchTolerance = 1e-9
+
+// complexStepSize é o passo usado no modo automático. Como não há subtração, o passo pode
+// ser tão pequeno quanto se queira sem perda por cancelamento.
+const complexStepSize = 1e-20
+
+// ComplexStep calcula a primeira derivada pelo método do passo complexo,
+// f'(x) ≈ Im(f(x + ih)) / h, com erro O(h²) e sem cancelamento subtrativo.
+// Só se aplica a funções analíticas.
+type ComplexStep struct {
+	// f é a extensão complexa da função a ser derivada.
+	f derivatives.ComplexFunc
+}
+
+// NewComplexStep cria a derivada pelo passo complexo da função f.
+func NewComplexStep(f derivatives.ComplexFunc) *ComplexStep {
+	if f == nil {
+		panic("função complexa nula para derivada por passo complexo")
+	}
+
+	return &ComplexStep{
+		f: f,
+	}
+}
+
+// Calculate executa o cálculo da derivada usando a extensão complexa F informada no
+// NewComplexStep. O argumento f deve ser a mesma função nos reais, como ComplexFunc.Real():
+// se f(x) e Re(F(x)) diferirem, retorna ErrFunctionMismatch em vez de derivar outra função.
+func (c *ComplexStep) Calculate(ctx context.Context, f derivatives.Func, x, h float64) (float64, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	if f == nil {
+		return 0, fmt.Errorf("%w: função nula", ErrFunctionMismatch)
+	}
+	want, got := real(c.f(complex(x, 0))), f(x)
+	if !(math.Abs(got-want) <= mismatchTolerance*math.Max(1, math.Abs(want))) {
+		return 0, fmt.Errorf("%w: f(%g) = %g, Re(F(%g)) = %g", ErrFunctionMismatch, x, got, x, want)
+	}
+
+	if h == 0 || math.IsNaN(h) {
+		return 0, fmt.Errorf("%w: %g", derivatives.ErrInvalidStep, h)
+	}
+	if h == derivatives.AutoStep {
+		h = complexStepSize
+	}
+
+	slog.DebugContext(ctx, "Calculando a derivada pelo passo complexo",
+		slog.Float64("x", x),
+		slog.Float64("h", h))
+
+	return imag(c.f(complex(x, h))) / h, nil
+}
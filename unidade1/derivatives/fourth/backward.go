@@ -0,0 +1,156 @@
+package fourth
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives"
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/stencil"
+)
+
+var (
+	_ derivatives.EstimatorInterface = (*Backward)(nil)
+	_ stencil.Describer              = (*Backward)(nil)
+)
+
+// Backward é uma struct que calcula a quarta derivada pela filosofia regressiva.
+type Backward struct {
+	// formula armazena a função de cálculo específica (com base na ordem de erro).
+	formula func(ctx context.Context, f derivatives.Func, x, h float64) (float64, error)
+	// errorOrder é a ordem de erro da fórmula, usada no modo de passo automático.
+	errorOrder uint64
+	// reference é a fórmula gerada de ordem de erro maior, usada para estimar o erro.
+	reference *stencil.Stencil
+	// generated é a fórmula gerada com os mesmos coeficientes de formula, usada na introspecção.
+	generated *stencil.Stencil
+}
+
+// NewBackward cria a quarta derivada regressiva com erro O(h^errorOrder), para errorOrder de 1 a 4.
+func NewBackward(errorOrder uint64) *Backward {
+	var selectedFormula func(ctx context.Context, f derivatives.Func, x, h float64) (float64, error)
+
+	switch errorOrder {
+	case 1:
+		selectedFormula = backwardOrder1
+	case 2:
+		selectedFormula = backwardOrder2
+	case 3:
+		selectedFormula = backwardOrder3
+	case 4:
+		selectedFormula = backwardOrder4
+	default:
+		panic(fmt.Sprintf("ordem de erro inválida para derivada regressiva: %d", errorOrder))
+	}
+
+	return &Backward{
+		formula:    selectedFormula,
+		errorOrder: errorOrder,
+		reference:  stencil.NewBackward(4, errorOrder+2),
+		generated:  stencil.NewBackward(4, errorOrder),
+	}
+}
+
+// Calculate executa o cálculo da derivada usando a fórmula que foi definida no NewBackward.
+func (b *Backward) Calculate(ctx context.Context, f derivatives.Func, x, h float64) (float64, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	h, err := derivatives.ResolveStep(ctx, b, f, x, h, 4, b.errorOrder)
+	if err != nil {
+		return 0, err
+	}
+
+	return b.formula(ctx, f, x, h)
+}
+
+// Estimate calcula a derivada e estima o erro de truncamento pela diferença para a
+// fórmula gerada com ordem de erro duas unidades maior.
+func (b *Backward) Estimate(ctx context.Context, f derivatives.Func, x, h float64) (derivatives.Result, error) {
+	h, err := derivatives.ResolveStep(ctx, b, f, x, h, 4, b.errorOrder)
+	if err != nil {
+		return derivatives.Result{}, err
+	}
+
+	return stencil.Estimate(ctx, b, b.reference, f, x, h)
+}
+
+// Describe retorna os deslocamentos, coeficientes, divisor e termo dominante do erro da fórmula.
+func (b *Backward) Describe() stencil.Description {
+	return b.generated.Describe()
+}
+
+func backwardOrder1(ctx context.Context, f derivatives.Func, x, h float64) (float64, error) {
+	slog.DebugContext(ctx, "Calculando a derivada regressiva de ordem 1",
+		slog.Uint64("ordem", uint64(1)),
+		slog.Float64("x", x),
+		slog.Float64("h", h))
+
+	h4 := h * h * h * h
+
+	numerador := (f(x-4*h) +
+		-4*f(x-3*h) +
+		6*f(x-2*h) +
+		-4*f(x-h) +
+		f(x))
+
+	return numerador / h4, nil
+}
+
+func backwardOrder2(ctx context.Context, f derivatives.Func, x, h float64) (float64, error) {
+	slog.DebugContext(ctx, "Calculando a derivada regressiva de ordem 2",
+		slog.Uint64("ordem", uint64(2)),
+		slog.Float64("x", x),
+		slog.Float64("h", h))
+
+	h4 := h * h * h * h
+
+	numerador := (-2*f(x-5*h) +
+		11*f(x-4*h) +
+		-24*f(x-3*h) +
+		26*f(x-2*h) +
+		-14*f(x-h) +
+		3*f(x))
+
+	return numerador / h4, nil
+}
+
+func backwardOrder3(ctx context.Context, f derivatives.Func, x, h float64) (float64, error) {
+	slog.DebugContext(ctx, "Calculando a derivada regressiva de ordem 3",
+		slog.Uint64("ordem", uint64(3)),
+		slog.Float64("x", x),
+		slog.Float64("h", h))
+
+	h4 := h * h * h * h
+
+	numerador := (17*f(x-6*h) +
+		-114*f(x-5*h) +
+		321*f(x-4*h) +
+		-484*f(x-3*h) +
+		411*f(x-2*h) +
+		-186*f(x-h) +
+		35*f(x))
+
+	return numerador / (6 * h4), nil
+}
+
+func backwardOrder4(ctx context.Context, f derivatives.Func, x, h float64) (float64, error) {
+	slog.DebugContext(ctx, "Calculando a derivada regressiva de ordem 4",
+		slog.Uint64("ordem", uint64(4)),
+		slog.Float64("x", x),
+		slog.Float64("h", h))
+
+	h4 := h * h * h * h
+
+	numerador := (-21*f(x-7*h) +
+		164*f(x-6*h) +
+		-555*f(x-5*h) +
+		1056*f(x-4*h) +
+		-1219*f(x-3*h) +
+		852*f(x-2*h) +
+		-333*f(x-h) +
+		56*f(x))
+
+	return numerador / (6 * h4), nil
+}
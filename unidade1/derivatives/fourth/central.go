@@ -0,0 +1,156 @@
+package fourth
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives"
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/stencil"
+)
+
+var (
+	_ derivatives.EstimatorInterface = (*Central)(nil)
+	_ stencil.Describer              = (*Central)(nil)
+)
+
+// Central é uma struct que calcula a quarta derivada pela filosofia central.
+type Central struct {
+	// formula armazena a função de cálculo específica (com base na ordem de erro).
+	formula func(ctx context.Context, f derivatives.Func, x, h float64) (float64, error)
+	// errorOrder é a ordem de erro da fórmula, usada no modo de passo automático.
+	errorOrder uint64
+	// reference é a fórmula gerada de ordem de erro maior, usada para estimar o erro.
+	reference *stencil.Stencil
+	// generated é a fórmula gerada com os mesmos coeficientes de formula, usada na introspecção.
+	generated *stencil.Stencil
+}
+
+// NewCentral cria a quarta derivada central com erro O(h^errorOrder), para errorOrder de 1 a 4.
+func NewCentral(errorOrder uint64) *Central {
+	var selectedFormula func(ctx context.Context, f derivatives.Func, x, h float64) (float64, error)
+
+	switch errorOrder {
+	case 1:
+		selectedFormula = centralOrder1
+	case 2:
+		selectedFormula = centralOrder2
+	case 3:
+		selectedFormula = centralOrder3
+	case 4:
+		selectedFormula = centralOrder4
+	default:
+		panic(fmt.Sprintf("ordem de erro inválida para derivada central: %d", errorOrder))
+	}
+
+	return &Central{
+		formula:    selectedFormula,
+		errorOrder: errorOrder,
+		reference:  stencil.NewCentral(4, errorOrder+2),
+		generated:  stencil.NewCentral(4, errorOrder),
+	}
+}
+
+// Calculate executa o cálculo da derivada usando a fórmula que foi definida no NewCentral.
+func (b *Central) Calculate(ctx context.Context, f derivatives.Func, x, h float64) (float64, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	h, err := derivatives.ResolveStep(ctx, b, f, x, h, 4, b.errorOrder)
+	if err != nil {
+		return 0, err
+	}
+
+	return b.formula(ctx, f, x, h)
+}
+
+// Estimate calcula a derivada e estima o erro de truncamento pela diferença para a
+// fórmula gerada com ordem de erro duas unidades maior.
+func (b *Central) Estimate(ctx context.Context, f derivatives.Func, x, h float64) (derivatives.Result, error) {
+	h, err := derivatives.ResolveStep(ctx, b, f, x, h, 4, b.errorOrder)
+	if err != nil {
+		return derivatives.Result{}, err
+	}
+
+	return stencil.Estimate(ctx, b, b.reference, f, x, h)
+}
+
+// Describe retorna os deslocamentos, coeficientes, divisor e termo dominante do erro da fórmula.
+func (b *Central) Describe() stencil.Description {
+	return b.generated.Describe()
+}
+
+func centralOrder1(ctx context.Context, f derivatives.Func, x, h float64) (float64, error) {
+	slog.DebugContext(ctx, "Calculando a derivada central de ordem 1",
+		slog.Uint64("ordem", uint64(1)),
+		slog.Float64("x", x),
+		slog.Float64("h", h))
+
+	h4 := h * h * h * h
+
+	numerador := (f(x-2*h) +
+		-4*f(x-h) +
+		6*f(x) +
+		-4*f(x+h) +
+		f(x+2*h))
+
+	return numerador / h4, nil
+}
+
+func centralOrder2(ctx context.Context, f derivatives.Func, x, h float64) (float64, error) {
+	slog.DebugContext(ctx, "Calculando a derivada central de ordem 2",
+		slog.Uint64("ordem", uint64(2)),
+		slog.Float64("x", x),
+		slog.Float64("h", h))
+
+	h4 := h * h * h * h
+
+	numerador := (f(x-2.5*h) +
+		-3*f(x-1.5*h) +
+		2*f(x-0.5*h) +
+		2*f(x+0.5*h) +
+		-3*f(x+1.5*h) +
+		f(x+2.5*h))
+
+	return numerador / (2 * h4), nil
+}
+
+func centralOrder3(ctx context.Context, f derivatives.Func, x, h float64) (float64, error) {
+	slog.DebugContext(ctx, "Calculando a derivada central de ordem 3",
+		slog.Uint64("ordem", uint64(3)),
+		slog.Float64("x", x),
+		slog.Float64("h", h))
+
+	h4 := h * h * h * h
+
+	numerador := (-f(x-3*h) +
+		12*f(x-2*h) +
+		-39*f(x-h) +
+		56*f(x) +
+		-39*f(x+h) +
+		12*f(x+2*h) +
+		-f(x+3*h))
+
+	return numerador / (6 * h4), nil
+}
+
+func centralOrder4(ctx context.Context, f derivatives.Func, x, h float64) (float64, error) {
+	slog.DebugContext(ctx, "Calculando a derivada central de ordem 4",
+		slog.Uint64("ordem", uint64(4)),
+		slog.Float64("x", x),
+		slog.Float64("h", h))
+
+	h4 := h * h * h * h
+
+	numerador := (-7*f(x-3.5*h) +
+		59*f(x-2.5*h) +
+		-135*f(x-1.5*h) +
+		83*f(x-0.5*h) +
+		83*f(x+0.5*h) +
+		-135*f(x+1.5*h) +
+		59*f(x+2.5*h) +
+		-7*f(x+3.5*h))
+
+	return numerador / (48 * h4), nil
+}
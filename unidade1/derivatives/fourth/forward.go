@@ -0,0 +1,157 @@
+// Package fourth implementa a derivada quarta em diferentes filosofias e ordem de erro.
+package fourth
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives"
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/stencil"
+)
+
+var (
+	_ derivatives.EstimatorInterface = (*Forward)(nil)
+	_ stencil.Describer              = (*Forward)(nil)
+)
+
+// Forward é uma struct que calcula a quarta derivada pela filosofia progressiva.
+type Forward struct {
+	// formula armazena a função de cálculo específica (com base na ordem de erro).
+	formula func(ctx context.Context, f derivatives.Func, x, h float64) (float64, error)
+	// errorOrder é a ordem de erro da fórmula, usada no modo de passo automático.
+	errorOrder uint64
+	// reference é a fórmula gerada de ordem de erro maior, usada para estimar o erro.
+	reference *stencil.Stencil
+	// generated é a fórmula gerada com os mesmos coeficientes de formula, usada na introspecção.
+	generated *stencil.Stencil
+}
+
+// NewForward cria a quarta derivada progressiva com erro O(h^errorOrder), para errorOrder de 1 a 4.
+func NewForward(errorOrder uint64) *Forward {
+	var selectedFormula func(ctx context.Context, f derivatives.Func, x, h float64) (float64, error)
+
+	switch errorOrder {
+	case 1:
+		selectedFormula = forwardOrder1
+	case 2:
+		selectedFormula = forwardOrder2
+	case 3:
+		selectedFormula = forwardOrder3
+	case 4:
+		selectedFormula = forwardOrder4
+	default:
+		panic(fmt.Sprintf("ordem de erro inválida para derivada progressiva: %d", errorOrder))
+	}
+
+	return &Forward{
+		formula:    selectedFormula,
+		errorOrder: errorOrder,
+		reference:  stencil.NewForward(4, errorOrder+2),
+		generated:  stencil.NewForward(4, errorOrder),
+	}
+}
+
+// Calculate executa o cálculo da derivada usando a fórmula que foi definida no NewForward.
+func (b *Forward) Calculate(ctx context.Context, f derivatives.Func, x, h float64) (float64, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	h, err := derivatives.ResolveStep(ctx, b, f, x, h, 4, b.errorOrder)
+	if err != nil {
+		return 0, err
+	}
+
+	return b.formula(ctx, f, x, h)
+}
+
+// Estimate calcula a derivada e estima o erro de truncamento pela diferença para a
+// fórmula gerada com ordem de erro duas unidades maior.
+func (b *Forward) Estimate(ctx context.Context, f derivatives.Func, x, h float64) (derivatives.Result, error) {
+	h, err := derivatives.ResolveStep(ctx, b, f, x, h, 4, b.errorOrder)
+	if err != nil {
+		return derivatives.Result{}, err
+	}
+
+	return stencil.Estimate(ctx, b, b.reference, f, x, h)
+}
+
+// Describe retorna os deslocamentos, coeficientes, divisor e termo dominante do erro da fórmula.
+func (b *Forward) Describe() stencil.Description {
+	return b.generated.Describe()
+}
+
+func forwardOrder1(ctx context.Context, f derivatives.Func, x, h float64) (float64, error) {
+	slog.DebugContext(ctx, "Calculando a derivada progressiva de ordem 1",
+		slog.Uint64("ordem", uint64(1)),
+		slog.Float64("x", x),
+		slog.Float64("h", h))
+
+	h4 := h * h * h * h
+
+	numerador := (f(x) +
+		-4*f(x+h) +
+		6*f(x+2*h) +
+		-4*f(x+3*h) +
+		f(x+4*h))
+
+	return numerador / h4, nil
+}
+
+func forwardOrder2(ctx context.Context, f derivatives.Func, x, h float64) (float64, error) {
+	slog.DebugContext(ctx, "Calculando a derivada progressiva de ordem 2",
+		slog.Uint64("ordem", uint64(2)),
+		slog.Float64("x", x),
+		slog.Float64("h", h))
+
+	h4 := h * h * h * h
+
+	numerador := (3*f(x) +
+		-14*f(x+h) +
+		26*f(x+2*h) +
+		-24*f(x+3*h) +
+		11*f(x+4*h) +
+		-2*f(x+5*h))
+
+	return numerador / h4, nil
+}
+
+func forwardOrder3(ctx context.Context, f derivatives.Func, x, h float64) (float64, error) {
+	slog.DebugContext(ctx, "Calculando a derivada progressiva de ordem 3",
+		slog.Uint64("ordem", uint64(3)),
+		slog.Float64("x", x),
+		slog.Float64("h", h))
+
+	h4 := h * h * h * h
+
+	numerador := (35*f(x) +
+		-186*f(x+h) +
+		411*f(x+2*h) +
+		-484*f(x+3*h) +
+		321*f(x+4*h) +
+		-114*f(x+5*h) +
+		17*f(x+6*h))
+
+	return numerador / (6 * h4), nil
+}
+
+func forwardOrder4(ctx context.Context, f derivatives.Func, x, h float64) (float64, error) {
+	slog.DebugContext(ctx, "Calculando a derivada progressiva de ordem 4",
+		slog.Uint64("ordem", uint64(4)),
+		slog.Float64("x", x),
+		slog.Float64("h", h))
+
+	h4 := h * h * h * h
+
+	numerador := (56*f(x) +
+		-333*f(x+h) +
+		852*f(x+2*h) +
+		-1219*f(x+3*h) +
+		1056*f(x+4*h) +
+		-555*f(x+5*h) +
+		164*f(x+6*h) +
+		-21*f(x+7*h))
+
+	return numerador / (6 * h4), nil
+}
@@ -0,0 +1,268 @@
+// Package multivariate calcula gradiente, jacobiana, hessiana, derivadas direcionais e
+// derivadas parciais mistas de funções de várias variáveis, reaproveitando as filosofias
+// (progressiva, regressiva e central) e as ordens de erro dos pacotes de derivadas.
+//
+// Um campo de vetores usado pelos métodos de EDO pode ser diferenciado envolvendo-o
+// em uma VectorFunc, fixando o tempo.
+package multivariate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives"
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/stencil"
+	"gonum.org/v1/gonum/mat"
+)
+
+// ScalarFunc é uma função f: ℝⁿ → ℝ.
+type ScalarFunc func(x *mat.VecDense) float64
+
+// VectorFunc é uma função F: ℝⁿ → ℝᵐ.
+type VectorFunc func(x *mat.VecDense) *mat.VecDense
+
+var (
+	// ErrDimension indica vetores de tamanhos incompatíveis.
+	ErrDimension = errors.New("multivariate: dimensões incompatíveis")
+	// ErrIndex indica um índice de variável fora do intervalo.
+	ErrIndex = errors.New("multivariate: índice de variável inválido")
+)
+
+// Differentiator calcula derivadas de funções de várias variáveis com uma filosofia e
+// ordem de erro fixas.
+type Differentiator struct {
+	// first é o estêncil da primeira derivada, usado em gradientes, jacobianas e parciais mistas.
+	first *stencil.Stencil
+	// second é o estêncil da segunda derivada, usado na diagonal da hessiana.
+	second *stencil.Stencil
+	// errorOrder é a ordem de erro dos estênceis, usada no modo de passo automático.
+	errorOrder uint64
+}
+
+// New cria um Differentiator com a filosofia e a ordem de erro informadas.
+func New(p stencil.Philosophy, errorOrder uint64) (*Differentiator, error) {
+	first, err := stencil.NewPhilosophy(p, 1, errorOrder)
+	if err != nil {
+		return nil, err
+	}
+	second, err := stencil.NewPhilosophy(p, 2, errorOrder)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Differentiator{first: first, second: second, errorOrder: errorOrder}, nil
+}
+
+// NewForward cria um Differentiator pela filosofia progressiva.
+func NewForward(errorOrder uint64) *Differentiator {
+	return mustNew(stencil.Forward, errorOrder)
+}
+
+// NewBackward cria um Differentiator pela filosofia regressiva.
+func NewBackward(errorOrder uint64) *Differentiator {
+	return mustNew(stencil.Backward, errorOrder)
+}
+
+// NewCentral cria um Differentiator pela filosofia central.
+func NewCentral(errorOrder uint64) *Differentiator {
+	return mustNew(stencil.Central, errorOrder)
+}
+
+func mustNew(p stencil.Philosophy, errorOrder uint64) *Differentiator {
+	d, err := New(p, errorOrder)
+	if err != nil {
+		panic(fmt.Sprintf("ordem de erro inválida para derivada %s: %d", p, errorOrder))
+	}
+	return d
+}
+
+// Directional calcula a derivada direcional ∇f(x)·v, derivando g(t) = f(x + t·v) em t = 0.
+// O vetor v não é normalizado.
+func (d *Differentiator) Directional(ctx context.Context, f ScalarFunc, x, v *mat.VecDense, h float64) (float64, error) {
+	if x.Len() != v.Len() {
+		return 0, fmt.Errorf("%w: x tem %d componentes e v tem %d", ErrDimension, x.Len(), v.Len())
+	}
+
+	h, err := d.step(1, x, h)
+	if err != nil {
+		return 0, err
+	}
+	return d.first.Calculate(ctx, line(f, x, v), 0, h)
+}
+
+// Partial calcula ∂f/∂xᵢ no ponto x.
+func (d *Differentiator) Partial(ctx context.Context, f ScalarFunc, x *mat.VecDense, i int, h float64) (float64, error) {
+	if i < 0 || i >= x.Len() {
+		return 0, fmt.Errorf("%w: %d", ErrIndex, i)
+	}
+
+	h, err := d.step(1, x, h)
+	if err != nil {
+		return 0, err
+	}
+	return d.first.Calculate(ctx, line(f, x, unit(x.Len(), i)), 0, h)
+}
+
+// MixedPartial calcula ∂²f/∂xᵢ∂xⱼ no ponto x. Com i ≠ j usa o produto tensorial do
+// estêncil da primeira derivada, mantendo a ordem de erro.
+func (d *Differentiator) MixedPartial(ctx context.Context, f ScalarFunc, x *mat.VecDense, i, j int, h float64) (float64, error) {
+	n := x.Len()
+	if i < 0 || i >= n || j < 0 || j >= n {
+		return 0, fmt.Errorf("%w: (%d, %d)", ErrIndex, i, j)
+	}
+	h, err := d.step(2, x, h)
+	if err != nil {
+		return 0, err
+	}
+	if i == j {
+		return d.second.Calculate(ctx, line(f, x, unit(n, i)), 0, h)
+	}
+
+	slog.DebugContext(ctx, "Calculando a derivada parcial mista",
+		slog.Int("i", i),
+		slog.Int("j", j),
+		slog.Float64("h", h))
+
+	offsets := d.first.Offsets()
+	weights := d.first.Weights()
+	point := mat.NewVecDense(n, nil)
+
+	var sum float64
+	for a, oa := range offsets {
+		if weights[a] == 0 {
+			continue
+		}
+		for b, ob := range offsets {
+			if weights[b] == 0 {
+				continue
+			}
+			point.CopyVec(x)
+			point.SetVec(i, x.AtVec(i)+oa*h)
+			point.SetVec(j, x.AtVec(j)+ob*h)
+			sum += weights[a] * weights[b] * f(point)
+		}
+	}
+
+	return sum / (h * h), ctx.Err()
+}
+
+// Gradient calcula o gradiente ∇f(x).
+func (d *Differentiator) Gradient(ctx context.Context, f ScalarFunc, x *mat.VecDense, h float64) (*mat.VecDense, error) {
+	n := x.Len()
+	grad := mat.NewVecDense(n, nil)
+
+	for i := range n {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		v, err := d.Partial(ctx, f, x, i, h)
+		if err != nil {
+			return nil, err
+		}
+		grad.SetVec(i, v)
+	}
+	return grad, nil
+}
+
+// Jacobian calcula a matriz jacobiana J[i][j] = ∂Fᵢ/∂xⱼ de F: ℝⁿ → ℝᵐ.
+// Cada ponto do estêncil avalia F uma única vez para todas as componentes.
+func (d *Differentiator) Jacobian(ctx context.Context, f VectorFunc, x *mat.VecDense, h float64) (*mat.Dense, error) {
+	n := x.Len()
+	h, err := d.step(1, x, h)
+	if err != nil {
+		return nil, err
+	}
+	offsets := d.first.Offsets()
+	weights := d.first.Weights()
+
+	var jac *mat.Dense
+	point := mat.NewVecDense(n, nil)
+
+	for j := range n {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		var column *mat.VecDense
+		for k, o := range offsets {
+			if weights[k] == 0 {
+				continue
+			}
+			point.CopyVec(x)
+			point.SetVec(j, x.AtVec(j)+o*h)
+			y := f(point)
+
+			if column == nil {
+				column = mat.NewVecDense(y.Len(), nil)
+			} else if y.Len() != column.Len() {
+				return nil, fmt.Errorf("%w: F retornou %d e %d componentes", ErrDimension, column.Len(), y.Len())
+			}
+			column.AddScaledVec(column, weights[k], y)
+		}
+		column.ScaleVec(1/h, column)
+
+		if jac == nil {
+			jac = mat.NewDense(column.Len(), n, nil)
+		} else if r, _ := jac.Dims(); r != column.Len() {
+			return nil, fmt.Errorf("%w: F retornou %d e %d componentes", ErrDimension, r, column.Len())
+		}
+		jac.SetCol(j, column.RawVector().Data)
+	}
+
+	return jac, nil
+}
+
+// Hessian calcula a matriz hessiana H[i][j] = ∂²f/∂xᵢ∂xⱼ. Apenas o triângulo superior
+// é calculado; a matriz retornada é simétrica.
+func (d *Differentiator) Hessian(ctx context.Context, f ScalarFunc, x *mat.VecDense, h float64) (*mat.Dense, error) {
+	n := x.Len()
+	hess := mat.NewDense(n, n, nil)
+
+	for i := range n {
+		for j := i; j < n; j++ {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+
+			v, err := d.MixedPartial(ctx, f, x, i, j, h)
+			if err != nil {
+				return nil, err
+			}
+			hess.Set(i, j, v)
+			hess.Set(j, i, v)
+		}
+	}
+	return hess, nil
+}
+
+// line restringe f à reta x + t·v.
+func line(f ScalarFunc, x, v *mat.VecDense) func(float64) float64 {
+	point := mat.NewVecDense(x.Len(), nil)
+	return func(t float64) float64 {
+		point.AddScaledVec(x, t, v)
+		return f(point)
+	}
+}
+
+// unit retorna o i-ésimo vetor da base canônica de ℝⁿ.
+func unit(n, i int) *mat.VecDense {
+	e := mat.NewVecDense(n, nil)
+	e.SetVec(i, 1)
+	return e
+}
+
+// step resolve derivatives.AutoStep para a derivada de ordem derivative, usando a maior
+// componente de x como escala. Retorna derivatives.ErrInvalidStep se h for nulo ou NaN.
+func (d *Differentiator) step(derivative uint64, x *mat.VecDense, h float64) (float64, error) {
+	if h == 0 || math.IsNaN(h) {
+		return 0, fmt.Errorf("%w: %g", derivatives.ErrInvalidStep, h)
+	}
+	if h != derivatives.AutoStep {
+		return h, nil
+	}
+	return derivatives.OptimalStep(derivative, d.errorOrder, mat.Norm(x, math.Inf(1)), 1), nil
+}
@@ -0,0 +1,148 @@
+package multivariate_test
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives"
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/multivariate"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gonum.org/v1/gonum/mat"
+)
+
+// rosenbrock(x, y) = (1-x)² + 100(y-x²)²
+func rosenbrock(v *mat.VecDense) float64 {
+	x, y := v.AtVec(0), v.AtVec(1)
+	return (1-x)*(1-x) + 100*(y-x*x)*(y-x*x)
+}
+
+func rosenbrockGradient(x, y float64) []float64 {
+	return []float64{-2*(1-x) - 400*x*(y-x*x), 200 * (y - x*x)}
+}
+
+func rosenbrockHessian(x, y float64) []float64 {
+	return []float64{
+		2 - 400*(y-x*x) + 800*x*x, -400 * x,
+		-400 * x, 200,
+	}
+}
+
+// polar(r, θ) = (r cos θ, r sin θ)
+func polar(v *mat.VecDense) *mat.VecDense {
+	r, theta := v.AtVec(0), v.AtVec(1)
+	return mat.NewVecDense(2, []float64{r * math.Cos(theta), r * math.Sin(theta)})
+}
+
+func TestDifferentiator_gradientAndHessian(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	x, y := 0.7, 1.3
+	point := mat.NewVecDense(2, []float64{x, y})
+
+	tests := []struct {
+		name           string
+		differentiator *multivariate.Differentiator
+		h              float64
+		tolerance      float64
+	}{
+		{"ForwardO2", multivariate.NewForward(2), 1e-4, 1e-3},
+		{"BackwardO3", multivariate.NewBackward(3), 1e-3, 1e-4},
+		{"CentralO2", multivariate.NewCentral(2), 1e-4, 1e-5},
+		{"CentralO4", multivariate.NewCentral(4), 1e-3, 1e-6},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			grad, err := tc.differentiator.Gradient(ctx, rosenbrock, point, tc.h)
+			require.NoError(t, err)
+			assert.InDeltaSlice(t, rosenbrockGradient(x, y), grad.RawVector().Data, tc.tolerance)
+
+			hess, err := tc.differentiator.Hessian(ctx, rosenbrock, point, tc.h)
+			require.NoError(t, err)
+			assert.InDeltaSlice(t, rosenbrockHessian(x, y), hess.RawMatrix().Data, 1e3*tc.tolerance)
+		})
+	}
+}
+
+func TestDifferentiator_jacobian(t *testing.T) {
+	t.Parallel()
+
+	r, theta := 2.0, 0.4
+	jac, err := multivariate.NewCentral(4).Jacobian(context.Background(), polar,
+		mat.NewVecDense(2, []float64{r, theta}), 1e-3)
+	require.NoError(t, err)
+
+	expected := []float64{
+		math.Cos(theta), -r * math.Sin(theta),
+		math.Sin(theta), r * math.Cos(theta),
+	}
+	assert.InDeltaSlice(t, expected, jac.RawMatrix().Data, 1e-10)
+}
+
+func TestDifferentiator_directionalAndMixed(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	d := multivariate.NewCentral(2)
+	x, y := 0.7, 1.3
+	point := mat.NewVecDense(2, []float64{x, y})
+	grad := rosenbrockGradient(x, y)
+
+	v := mat.NewVecDense(2, []float64{3, -1})
+	got, err := d.Directional(ctx, rosenbrock, point, v, 1e-4)
+	require.NoError(t, err)
+	assert.InDelta(t, 3*grad[0]-grad[1], got, 1e-4)
+
+	mixed, err := d.MixedPartial(ctx, rosenbrock, point, 0, 1, derivatives.AutoStep)
+	require.NoError(t, err)
+	assert.InDelta(t, -400*x, mixed, 1e-3)
+}
+
+func TestDifferentiator_invalidInput(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	d := multivariate.NewForward(1)
+	point := mat.NewVecDense(2, []float64{1, 1})
+
+	_, err := d.Partial(ctx, rosenbrock, point, 2, 1e-3)
+	assert.ErrorIs(t, err, multivariate.ErrIndex)
+
+	_, err = d.Directional(ctx, rosenbrock, point, mat.NewVecDense(3, nil), 1e-3)
+	assert.ErrorIs(t, err, multivariate.ErrDimension)
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = d.Gradient(canceled, rosenbrock, point, 1e-3)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	assert.Panics(t, func() { multivariate.NewCentral(0) })
+}
+
+func TestDifferentiator_invalidStep(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	d := multivariate.NewCentral(2)
+	point := mat.NewVecDense(2, []float64{0.7, 1.3})
+
+	for _, h := range []float64{0, math.NaN()} {
+		_, err := d.Jacobian(ctx, polar, point, h)
+		assert.ErrorIs(t, err, derivatives.ErrInvalidStep, "Jacobian com h=%g", h)
+
+		_, err = d.MixedPartial(ctx, rosenbrock, point, 0, 1, h)
+		assert.ErrorIs(t, err, derivatives.ErrInvalidStep, "MixedPartial mista com h=%g", h)
+
+		_, err = d.MixedPartial(ctx, rosenbrock, point, 0, 0, h)
+		assert.ErrorIs(t, err, derivatives.ErrInvalidStep, "MixedPartial pura com h=%g", h)
+
+		_, err = d.Hessian(ctx, rosenbrock, point, h)
+		assert.ErrorIs(t, err, derivatives.ErrInvalidStep, "Hessian com h=%g", h)
+
+		_, err = d.Directional(ctx, rosenbrock, point, point, h)
+		assert.ErrorIs(t, err, derivatives.ErrInvalidStep, "Directional com h=%g", h)
+	}
+}
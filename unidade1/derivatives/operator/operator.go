@@ -0,0 +1,266 @@
+// Package operator monta as matrizes de diferenças finitas que aplicam uma derivada a
+// todos os pontos de uma malha uniforme, como as usadas na discretização de problemas de
+// valor de contorno. As linhas interiores usam o estêncil da filosofia escolhida; as linhas
+// de borda, em que esse estêncil sairia da malha, são fechadas conforme o Closure.
+package operator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/stencil"
+	"gonum.org/v1/gonum/mat"
+)
+
+var (
+	// ErrGridTooSmall indica uma malha com menos pontos que o estêncil.
+	ErrGridTooSmall = errors.New("operator: malha menor que o estêncil")
+	// ErrInvalidStep indica um passo não positivo.
+	ErrInvalidStep = errors.New("operator: passo inválido")
+	// ErrLength indica amostras em quantidade diferente da de pontos da malha.
+	ErrLength = errors.New("operator: quantidade de amostras diferente da de pontos")
+)
+
+// Closure define como são montadas as linhas de borda.
+type Closure int
+
+const (
+	// OneSided fecha as bordas com a fórmula progressiva (à esquerda) ou regressiva
+	// (à direita) de mesma ordem de erro.
+	OneSided Closure = iota
+	// Shifted usa a mesma quantidade de pontos das linhas interiores, deslocando a janela
+	// para dentro da malha. Mantém a banda estreita, mas nas linhas de borda das fórmulas
+	// centrais a ordem de erro cai, pois o estêncil descentrado perde a simetria.
+	Shifted
+	// Open deixa as linhas de borda nulas, para serem substituídas pelas condições de contorno.
+	Open
+)
+
+// String retorna o nome do fechamento.
+func (c Closure) String() string {
+	switch c {
+	case OneSided:
+		return "unilateral"
+	case Shifted:
+		return "deslocado"
+	case Open:
+		return "aberto"
+	default:
+		return fmt.Sprintf("Closure(%d)", int(c))
+	}
+}
+
+// Operator é a matriz N×N da derivada de ordem derivative numa malha uniforme de passo h.
+// Cada linha é guardada de forma esparsa, pela primeira coluna e pelos pesos contíguos.
+type Operator struct {
+	// derivative é a ordem da derivada.
+	derivative uint64
+	// size é a quantidade de pontos da malha.
+	size int
+	// starts[i] é a coluna do primeiro peso da linha i.
+	starts []int
+	// rows[i] são os pesos da linha i, já divididos por hᵈ.
+	rows [][]float64
+}
+
+// First monta o operador da primeira derivada.
+func First(p stencil.Philosophy, errorOrder uint64, size int, h float64, closure Closure) (*Operator, error) {
+	return New(1, p, errorOrder, size, h, closure)
+}
+
+// Second monta o operador da segunda derivada.
+func Second(p stencil.Philosophy, errorOrder uint64, size int, h float64, closure Closure) (*Operator, error) {
+	return New(2, p, errorOrder, size, h, closure)
+}
+
+// Third monta o operador da terceira derivada.
+func Third(p stencil.Philosophy, errorOrder uint64, size int, h float64, closure Closure) (*Operator, error) {
+	return New(3, p, errorOrder, size, h, closure)
+}
+
+// New monta o operador da derivada de ordem derivative com erro O(h^errorOrder) numa malha
+// de size pontos com passo h.
+//
+// Seguindo os pacotes first, second e third, as fórmulas unilaterais usam
+// derivative + errorOrder pontos. As centrais usam a menor quantidade ímpar de pontos que
+// atinge a ordem pedida, para que todos os pontos caiam na malha e a banda seja a mais
+// estreita possível. A filosofia HalfStep não tem pontos na malha e não é aceita. Retorna
+// ErrGridTooSmall se a malha tiver menos pontos que o estêncil ou, com OneSided, que as
+// fórmulas unilaterais das bordas.
+func New(derivative uint64, p stencil.Philosophy, errorOrder uint64, size int, h float64, closure Closure) (*Operator, error) {
+	if derivative == 0 || errorOrder == 0 {
+		return nil, fmt.Errorf("operator: ordens inválidas: derivada %d, erro %d", derivative, errorOrder)
+	}
+	if !(h > 0) || math.IsInf(h, 0) {
+		return nil, fmt.Errorf("%w: %g", ErrInvalidStep, h)
+	}
+
+	if p == stencil.HalfStep {
+		return nil, fmt.Errorf("operator: a filosofia %s não tem pontos na malha", p)
+	}
+
+	points := int(derivative + errorOrder)
+	if p == stencil.Central {
+		points = stencil.CentralPoints(derivative, errorOrder)
+	}
+
+	interior, err := stencil.Offsets(p, points)
+	if err != nil {
+		return nil, err
+	}
+	if size < points {
+		return nil, fmt.Errorf("%w: %d pontos para um estêncil de %d", ErrGridTooSmall, size, points)
+	}
+	// As linhas de borda unilaterais usam derivative + errorOrder pontos, mais que o estêncil
+	// central quando a ordem de erro é alta.
+	if oneSided := int(derivative + errorOrder); closure == OneSided && size < oneSided {
+		return nil, fmt.Errorf("%w: %d pontos para o fechamento unilateral de %d", ErrGridTooSmall, size, oneSided)
+	}
+
+	interiorWeights, err := weights(derivative, interior, h)
+	if err != nil {
+		return nil, err
+	}
+	left, right := int(interior[0]), int(interior[len(interior)-1])
+
+	op := &Operator{
+		derivative: derivative,
+		size:       size,
+		starts:     make([]int, size),
+		rows:       make([][]float64, size),
+	}
+	for i := range size {
+		if i+left >= 0 && i+right < size {
+			op.starts[i] = i + left
+			op.rows[i] = interiorWeights
+			continue
+		}
+
+		var lo, hi int
+		switch closure {
+		case OneSided:
+			n := int(derivative + errorOrder)
+			if i+left < 0 {
+				lo = min(i, size-n)
+			} else {
+				lo = max(i-n+1, 0)
+			}
+			hi = lo + n
+		case Shifted:
+			lo = min(max(i+left, 0), size-points)
+			hi = lo + points
+		case Open:
+			op.starts[i] = i
+			continue
+		default:
+			return nil, fmt.Errorf("operator: fechamento desconhecido: %s", closure)
+		}
+
+		offsets := make([]float64, hi-lo)
+		for k := range offsets {
+			offsets[k] = float64(lo + k - i)
+		}
+		w, err := weights(derivative, offsets, h)
+		if err != nil {
+			return nil, err
+		}
+		op.starts[i] = lo
+		op.rows[i] = w
+	}
+
+	return op, nil
+}
+
+// weights retorna os pesos do estêncil de deslocamentos offsets, divididos por hᵈ.
+func weights(derivative uint64, offsets []float64, h float64) ([]float64, error) {
+	s, err := stencil.New(derivative, offsets)
+	if err != nil {
+		return nil, err
+	}
+
+	scale := math.Pow(h, float64(derivative))
+	w := s.Weights()
+	for k := range w {
+		w[k] /= scale
+	}
+	return w, nil
+}
+
+// Size retorna a quantidade de pontos da malha.
+func (o *Operator) Size() int {
+	return o.size
+}
+
+// Derivative retorna a ordem da derivada.
+func (o *Operator) Derivative() uint64 {
+	return o.derivative
+}
+
+// Row retorna a linha i de forma esparsa: a coluna do primeiro peso e uma cópia dos pesos.
+func (o *Operator) Row(i int) (int, []float64) {
+	return o.starts[i], append([]float64(nil), o.rows[i]...)
+}
+
+// Bandwidth retorna as quantidades de diagonais abaixo (kl) e acima (ku) da principal.
+func (o *Operator) Bandwidth() (int, int) {
+	var kl, ku int
+	for i, w := range o.rows {
+		if len(w) == 0 {
+			continue
+		}
+		kl = max(kl, i-o.starts[i])
+		ku = max(ku, o.starts[i]+len(w)-1-i)
+	}
+	return kl, ku
+}
+
+// Band retorna o operador como matriz em banda.
+func (o *Operator) Band() *mat.BandDense {
+	kl, ku := o.Bandwidth()
+	b := mat.NewBandDense(o.size, o.size, kl, ku, nil)
+	for i, w := range o.rows {
+		for k, v := range w {
+			b.SetBand(i, o.starts[i]+k, v)
+		}
+	}
+	return b
+}
+
+// Dense retorna o operador como matriz densa.
+func (o *Operator) Dense() *mat.Dense {
+	d := mat.NewDense(o.size, o.size, nil)
+	for i, w := range o.rows {
+		for k, v := range w {
+			d.Set(i, o.starts[i]+k, v)
+		}
+	}
+	return d
+}
+
+// Apply aplica o operador às amostras ys da malha.
+func (o *Operator) Apply(ctx context.Context, ys []float64) ([]float64, error) {
+	if len(ys) != o.size {
+		return nil, fmt.Errorf("%w: %d amostras para %d pontos", ErrLength, len(ys), o.size)
+	}
+
+	slog.DebugContext(ctx, "Aplicando o operador de diferenças finitas",
+		slog.Uint64("derivada", o.derivative),
+		slog.Int("pontos", o.size))
+
+	out := make([]float64, o.size)
+	for i, w := range o.rows {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		var sum float64
+		for k, v := range w {
+			sum += v * ys[o.starts[i]+k]
+		}
+		out[i] = sum
+	}
+	return out, nil
+}
@@ -0,0 +1,207 @@
+package operator_test
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/autodiff"
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/operator"
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/stencil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gonum.org/v1/gonum/mat"
+)
+
+func expSinTaylor(x autodiff.Taylor) autodiff.Taylor {
+	return x.Sin().Exp()
+}
+
+// grid retorna size pontos igualmente espaçados em [0, 1] e o passo.
+func grid(size int) ([]float64, float64) {
+	h := 1 / float64(size-1)
+	xs := make([]float64, size)
+	for i := range xs {
+		xs[i] = float64(i) * h
+	}
+	return xs, h
+}
+
+func TestOperator_accuracy(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	xs, h := grid(101)
+	ys := make([]float64, len(xs))
+	for i, x := range xs {
+		ys[i] = math.Exp(math.Sin(x))
+	}
+
+	tests := []struct {
+		name       string
+		derivative uint64
+		philosophy stencil.Philosophy
+		errorOrder uint64
+		closure    operator.Closure
+		tolerance  float64
+	}{
+		{"FirstForwardO2OneSided", 1, stencil.Forward, 2, operator.OneSided, 1e-3},
+		{"FirstBackwardO3Shifted", 1, stencil.Backward, 3, operator.Shifted, 1e-5},
+		{"FirstCentralO4OneSided", 1, stencil.Central, 4, operator.OneSided, 1e-7},
+		{"SecondCentralO2OneSided", 2, stencil.Central, 2, operator.OneSided, 1e-3},
+		{"SecondCentralO4Shifted", 2, stencil.Central, 4, operator.Shifted, 1e-4},
+		{"ThirdCentralO2OneSided", 3, stencil.Central, 2, operator.OneSided, 1e-2},
+		{"ThirdForwardO4Shifted", 3, stencil.Forward, 4, operator.Shifted, 1e-4},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			op, err := operator.New(tc.derivative, tc.philosophy, tc.errorOrder, len(xs), h, tc.closure)
+			require.NoError(t, err)
+
+			got, err := op.Apply(ctx, ys)
+			require.NoError(t, err)
+
+			for i, x := range xs {
+				expected := autodiff.NthDerivative(expSinTaylor, x, int(tc.derivative))
+				assert.InDeltaf(t, expected, got[i], tc.tolerance, "x=%g", x)
+			}
+
+			// As representações em banda e densa são a mesma matriz.
+			assert.True(t, mat.Equal(op.Band(), op.Dense()))
+		})
+	}
+}
+
+func TestOperator_rows(t *testing.T) {
+	t.Parallel()
+
+	h := 0.5
+	op, err := operator.First(stencil.Central, 2, 6, h, operator.OneSided)
+	require.NoError(t, err)
+
+	// Interior: (-1, 0, 1)/2h.
+	start, w := op.Row(2)
+	assert.Equal(t, 1, start)
+	assert.InDeltaSlice(t, []float64{-1, 0, 1}, w, 1e-15)
+
+	// Bordas: progressiva (-3, 4, -1)/2h e regressiva (1, -4, 3)/2h.
+	start, w = op.Row(0)
+	assert.Equal(t, 0, start)
+	assert.InDeltaSlice(t, []float64{-3, 4, -1}, w, 1e-15)
+	start, w = op.Row(5)
+	assert.Equal(t, 3, start)
+	assert.InDeltaSlice(t, []float64{1, -4, 3}, w, 1e-15)
+
+	kl, ku := op.Bandwidth()
+	assert.Equal(t, 2, kl)
+	assert.Equal(t, 2, ku)
+}
+
+func TestOperator_open(t *testing.T) {
+	t.Parallel()
+
+	op, err := operator.Second(stencil.Central, 2, 5, 1, operator.Open)
+	require.NoError(t, err)
+
+	expected := mat.NewDense(5, 5, []float64{
+		0, 0, 0, 0, 0,
+		1, -2, 1, 0, 0,
+		0, 1, -2, 1, 0,
+		0, 0, 1, -2, 1,
+		0, 0, 0, 0, 0,
+	})
+	assert.True(t, mat.EqualApprox(expected, op.Dense(), 1e-15))
+
+	kl, ku := op.Bandwidth()
+	assert.Equal(t, 1, kl)
+	assert.Equal(t, 1, ku)
+}
+
+func TestOperator_boundaryValueMask(t *testing.T) {
+	t.Parallel()
+
+	// A máscara de três pontos de y'' + 7y' - y da unidade 5, com dx = 0.1.
+	dx := 0.1
+	size := 21
+
+	d2, err := operator.Second(stencil.Central, 2, size, dx, operator.Open)
+	require.NoError(t, err)
+	d1, err := operator.First(stencil.Central, 2, size, dx, operator.Open)
+	require.NoError(t, err)
+
+	var a mat.Dense
+	a.Scale(7, d1.Dense())
+	a.Add(d2.Dense(), &a)
+	for i := 1; i < size-1; i++ {
+		a.Set(i, i, a.At(i, i)-1)
+	}
+
+	mask := []float64{
+		1/(dx*dx) - 7/(2*dx),
+		-2/(dx*dx) - 1,
+		1/(dx*dx) + 7/(2*dx),
+	}
+	for i := 1; i < size-1; i++ {
+		assert.InDeltaSlice(t, mask, a.RawRowView(i)[i-1:i+2], 1e-9)
+	}
+}
+
+func TestOperator_invalidInput(t *testing.T) {
+	t.Parallel()
+
+	_, err := operator.First(stencil.Central, 4, 4, 0.1, operator.OneSided)
+	require.ErrorIs(t, err, operator.ErrGridTooSmall)
+
+	_, err = operator.First(stencil.Central, 2, 10, 0, operator.OneSided)
+	require.ErrorIs(t, err, operator.ErrInvalidStep)
+
+	_, err = operator.First(stencil.HalfStep, 2, 10, 0.1, operator.OneSided)
+	require.Error(t, err)
+
+	_, err = operator.New(0, stencil.Forward, 2, 10, 0.1, operator.OneSided)
+	require.Error(t, err)
+
+	op, err := operator.First(stencil.Forward, 1, 10, 0.1, operator.OneSided)
+	require.NoError(t, err)
+	_, err = op.Apply(context.Background(), make([]float64, 3))
+	require.ErrorIs(t, err, operator.ErrLength)
+}
+
+func TestNew_OneSidedGridSize(t *testing.T) {
+	t.Parallel()
+
+	// O estêncil central cabe na malha, mas as fórmulas unilaterais das bordas, de
+	// derivative + errorOrder pontos, não.
+	tests := []struct {
+		name                   string
+		derivative, errorOrder uint64
+		size                   int
+		tooSmall               bool
+	}{
+		{"d2 p2 em 3 pontos", 2, 2, 3, true},
+		{"d2 p2 em 4 pontos", 2, 2, 4, false},
+		{"d2 p4 em 5 pontos", 2, 4, 5, true},
+		{"d2 p4 em 6 pontos", 2, 4, 6, false},
+		{"d1 p4 em 5 pontos", 1, 4, 5, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			op, err := operator.New(tt.derivative, stencil.Central, tt.errorOrder, tt.size, 0.1, operator.OneSided)
+			if tt.tooSmall {
+				require.ErrorIs(t, err, operator.ErrGridTooSmall)
+				return
+			}
+			require.NoError(t, err)
+			_, err = op.Apply(context.Background(), make([]float64, tt.size))
+			require.NoError(t, err)
+			require.NotPanics(t, func() { op.Dense() })
+		})
+	}
+
+	// Os outros fechamentos só precisam do estêncil central.
+	_, err := operator.Second(stencil.Central, 2, 3, 0.1, operator.Shifted)
+	require.NoError(t, err)
+}
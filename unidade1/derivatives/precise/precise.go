@@ -0,0 +1,99 @@
+// Package precise avalia as fórmulas de diferenças finitas em precisão arbitrária
+// (math/big.Float), para separar o erro de arredondamento do erro de truncamento: com
+// precisão suficiente, o erro total segue C·hᵖ até passos muito menores que em float64.
+package precise
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/big"
+
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives"
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/stencil"
+)
+
+// ErrInvalidPrecision indica uma precisão nula.
+var ErrInvalidPrecision = errors.New("precise: precisão inválida")
+
+// Formula é uma fórmula de diferenças finitas avaliada em precisão arbitrária.
+type Formula struct {
+	// derivative é a ordem da derivada.
+	derivative uint64
+	// offsets são os deslocamentos, em unidades de h.
+	offsets []*big.Float
+	// coefficients são os coeficientes inteiros da fórmula.
+	coefficients []*big.Float
+	// divisor é o divisor da fórmula.
+	divisor *big.Float
+	// prec é a precisão, em bits, de todas as operações.
+	prec uint
+}
+
+// New cria a variante em precisão arbitrária de uma fórmula, como first.NewCentral(4), a
+// partir dos seus coeficientes exatos. Todas as operações usam prec bits de mantissa.
+func New(method stencil.Describer, prec uint) (*Formula, error) {
+	if prec == 0 {
+		return nil, fmt.Errorf("%w: %d bits", ErrInvalidPrecision, prec)
+	}
+
+	desc := method.Describe()
+	f := &Formula{
+		derivative: desc.Derivative,
+		divisor:    new(big.Float).SetPrec(prec).SetFloat64(desc.Divisor),
+		prec:       prec,
+	}
+	for i, c := range desc.Coefficients {
+		if c == 0 {
+			continue
+		}
+		// Coeficientes inteiros e deslocamentos múltiplos de ½ são exatos em float64.
+		f.coefficients = append(f.coefficients, new(big.Float).SetPrec(prec).SetFloat64(c))
+		f.offsets = append(f.offsets, new(big.Float).SetPrec(prec).SetFloat64(desc.Offsets[i]))
+	}
+	return f, nil
+}
+
+// Precision retorna a precisão da fórmula, em bits.
+func (f *Formula) Precision() uint {
+	return f.prec
+}
+
+// Float converte v para a precisão da fórmula.
+func (f *Formula) Float(v float64) *big.Float {
+	return new(big.Float).SetPrec(f.prec).SetFloat64(v)
+}
+
+// Calculate aplica a fórmula em fn no ponto x com passo h, na precisão da fórmula.
+func (f *Formula) Calculate(ctx context.Context, fn derivatives.BigFunc, x, h *big.Float) (*big.Float, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if h.Sign() == 0 {
+		return nil, fmt.Errorf("precise: passo nulo")
+	}
+
+	slog.DebugContext(ctx, "Calculando a derivada em precisão arbitrária",
+		slog.Uint64("derivada", f.derivative),
+		slog.Uint64("bits", uint64(f.prec)),
+		slog.String("x", x.Text('g', 10)),
+		slog.String("h", h.Text('g', 10)))
+
+	sum := new(big.Float).SetPrec(f.prec)
+	term := new(big.Float).SetPrec(f.prec)
+	for i, c := range f.coefficients {
+		// Um ponto novo a cada avaliação, pois fn pode guardar ou alterar o argumento.
+		point := new(big.Float).SetPrec(f.prec).Mul(f.offsets[i], h)
+		point.Add(point, x)
+		term.Mul(c, fn(point))
+		sum.Add(sum, term)
+	}
+
+	// divisor·hᵈ
+	scale := new(big.Float).SetPrec(f.prec).Set(f.divisor)
+	for range f.derivative {
+		scale.Mul(scale, h)
+	}
+	return sum.Quo(sum, scale), nil
+}
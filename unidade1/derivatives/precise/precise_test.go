@@ -0,0 +1,135 @@
+package precise_test
+
+import (
+	"context"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/first"
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/precise"
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/second"
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/stencil"
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/third"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const bits = 256
+
+// runge é f(x) = 1/(1+x²) em precisão arbitrária.
+func runge(x *big.Float) *big.Float {
+	prec := x.Prec()
+	den := new(big.Float).SetPrec(prec).Mul(x, x)
+	den.Add(den, big.NewFloat(1))
+	return new(big.Float).SetPrec(prec).Quo(big.NewFloat(1), den)
+}
+
+// rungeDerivative retorna a derivada de ordem n de 1/(1+x²) em x, calculada com 256 bits.
+func rungeDerivative(n int, x float64) *big.Float {
+	v := func(f float64) *big.Float { return new(big.Float).SetPrec(bits).SetFloat64(f) }
+	bx := v(x)
+	x2 := new(big.Float).SetPrec(bits).Mul(bx, bx)
+	u := new(big.Float).SetPrec(bits).Add(x2, v(1)) // 1 + x²
+
+	pow := func(k int) *big.Float {
+		out := v(1)
+		for range k {
+			out.Mul(out, u)
+		}
+		return out
+	}
+
+	num := v(0)
+	switch n {
+	case 1: // -2x/(1+x²)²
+		num.Mul(v(-2), bx)
+	case 2: // (6x² - 2)/(1+x²)³
+		num.Mul(v(6), x2)
+		num.Sub(num, v(2))
+	case 3: // 24x(1 - x²)/(1+x²)⁴
+		num.Sub(v(1), x2)
+		num.Mul(num, bx)
+		num.Mul(num, v(24))
+	}
+	return num.Quo(num, pow(n+1))
+}
+
+func absDiff(a, b *big.Float) float64 {
+	d, _ := new(big.Float).SetPrec(bits).Sub(a, b).Float64()
+	return math.Abs(d)
+}
+
+func TestFormula_highPrecision(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	x := 0.7
+
+	tests := []struct {
+		name       string
+		method     stencil.Describer
+		derivative int
+		h          float64
+		tolerance  float64
+	}{
+		// Em float64, h tão pequeno destruiria o resultado por cancelamento.
+		{"FirstCentralO2", first.NewCentral(2), 1, 1e-20, 1e-38},
+		{"FirstForwardO4", first.NewForward(4), 1, 1e-12, 1e-45},
+		{"SecondCentralO3", second.NewCentral(3), 2, 1e-15, 1e-27},
+		{"ThirdBackwardO4", third.NewBackward(4), 3, 1e-8, 1e-27},
+		{"ThirdCentralO3", third.NewCentral(3), 3, 1e-10, 1e-36},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			f, err := precise.New(tc.method, bits)
+			require.NoError(t, err)
+			assert.Equal(t, uint(bits), f.Precision())
+
+			got, err := f.Calculate(ctx, runge, f.Float(x), f.Float(tc.h))
+			require.NoError(t, err)
+			assert.Equal(t, uint(bits), got.Prec())
+
+			assert.Less(t, absDiff(got, rungeDerivative(tc.derivative, x)), tc.tolerance)
+		})
+	}
+}
+
+func TestFormula_float64PrecisionMatchesHandWritten(t *testing.T) {
+	t.Parallel()
+
+	// Com 53 bits a variante reproduz a fórmula em float64 (a menos da ordem das somas).
+	ctx := context.Background()
+	x, h := 0.7, 1e-3
+
+	f, err := precise.New(first.NewCentral(4), 53)
+	require.NoError(t, err)
+	got, err := f.Calculate(ctx, runge, f.Float(x), f.Float(h))
+	require.NoError(t, err)
+	got64, _ := got.Float64()
+
+	expected, err := first.NewCentral(4).Calculate(ctx, func(x float64) float64 {
+		return 1 / (1 + x*x)
+	}, x, h)
+	require.NoError(t, err)
+
+	assert.InEpsilon(t, expected, got64, 1e-12)
+}
+
+func TestFormula_invalidInput(t *testing.T) {
+	t.Parallel()
+
+	_, err := precise.New(first.NewCentral(2), 0)
+	require.ErrorIs(t, err, precise.ErrInvalidPrecision)
+
+	f, err := precise.New(first.NewCentral(2), bits)
+	require.NoError(t, err)
+	_, err = f.Calculate(context.Background(), runge, f.Float(1), f.Float(0))
+	require.Error(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = f.Calculate(ctx, runge, f.Float(1), f.Float(1e-3))
+	require.ErrorIs(t, err, context.Canceled)
+}
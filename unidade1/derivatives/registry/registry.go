@@ -0,0 +1,104 @@
+// Package registry localiza as fórmulas escritas à mão dos pacotes first, second, third e
+// fourth pela ordem da derivada, filosofia e ordem de erro. Ao contrário dos construtores
+// desses pacotes, que entram em pânico, combinações não suportadas retornam erro.
+package registry
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives"
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/first"
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/fourth"
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/second"
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/stencil"
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/third"
+)
+
+// ErrUnsupported indica uma combinação de derivada, filosofia e ordem de erro sem fórmula.
+var ErrUnsupported = errors.New("registry: combinação não suportada")
+
+// maxErrorOrder é a maior ordem de erro implementada à mão.
+const maxErrorOrder = 4
+
+// Key identifica uma fórmula.
+type Key struct {
+	// Derivative é a ordem da derivada.
+	Derivative uint64
+	// Philosophy é a disposição dos pontos.
+	Philosophy stencil.Philosophy
+	// ErrorOrder é a ordem de erro.
+	ErrorOrder uint64
+}
+
+// String retorna a chave no formato "derivada 2 central O(h^4)".
+func (k Key) String() string {
+	return fmt.Sprintf("derivada %d %s O(h^%d)", k.Derivative, k.Philosophy, k.ErrorOrder)
+}
+
+// constructor cria a fórmula de uma ordem de erro entre 1 e maxErrorOrder.
+type constructor func(errorOrder uint64) derivatives.EstimatorInterface
+
+// constructors[d][p] é o construtor da derivada d na filosofia p.
+var constructors = map[uint64]map[stencil.Philosophy]constructor{
+	1: {
+		stencil.Forward:  func(o uint64) derivatives.EstimatorInterface { return first.NewForward(o) },
+		stencil.Backward: func(o uint64) derivatives.EstimatorInterface { return first.NewBackward(o) },
+		stencil.Central:  func(o uint64) derivatives.EstimatorInterface { return first.NewCentral(o) },
+	},
+	2: {
+		stencil.Forward:  func(o uint64) derivatives.EstimatorInterface { return second.NewForward(o) },
+		stencil.Backward: func(o uint64) derivatives.EstimatorInterface { return second.NewBackward(o) },
+		stencil.Central:  func(o uint64) derivatives.EstimatorInterface { return second.NewCentral(o) },
+	},
+	3: {
+		stencil.Forward:  func(o uint64) derivatives.EstimatorInterface { return third.NewForward(o) },
+		stencil.Backward: func(o uint64) derivatives.EstimatorInterface { return third.NewBackward(o) },
+		stencil.Central:  func(o uint64) derivatives.EstimatorInterface { return third.NewCentral(o) },
+	},
+	4: {
+		stencil.Forward:  func(o uint64) derivatives.EstimatorInterface { return fourth.NewForward(o) },
+		stencil.Backward: func(o uint64) derivatives.EstimatorInterface { return fourth.NewBackward(o) },
+		stencil.Central:  func(o uint64) derivatives.EstimatorInterface { return fourth.NewCentral(o) },
+	},
+}
+
+// Lookup retorna a fórmula da derivada de ordem derivative, filosofia p e erro O(h^errorOrder).
+func Lookup(derivative uint64, p stencil.Philosophy, errorOrder uint64) (derivatives.EstimatorInterface, error) {
+	key := Key{Derivative: derivative, Philosophy: p, ErrorOrder: errorOrder}
+
+	byPhilosophy, ok := constructors[derivative]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupported, key)
+	}
+	newMethod, ok := byPhilosophy[p]
+	if !ok || errorOrder < 1 || errorOrder > maxErrorOrder {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupported, key)
+	}
+	return newMethod(errorOrder), nil
+}
+
+// Keys retorna todas as combinações suportadas, ordenadas por derivada, filosofia e ordem de erro.
+func Keys() []Key {
+	var keys []Key
+	for d, byPhilosophy := range constructors {
+		for p := range byPhilosophy {
+			for o := uint64(1); o <= maxErrorOrder; o++ {
+				keys = append(keys, Key{Derivative: d, Philosophy: p, ErrorOrder: o})
+			}
+		}
+	}
+
+	slices.SortFunc(keys, func(a, b Key) int {
+		switch {
+		case a.Derivative != b.Derivative:
+			return int(a.Derivative) - int(b.Derivative)
+		case a.Philosophy != b.Philosophy:
+			return int(a.Philosophy) - int(b.Philosophy)
+		default:
+			return int(a.ErrorOrder) - int(b.ErrorOrder)
+		}
+	})
+	return keys
+}
@@ -0,0 +1,69 @@
+package registry_test
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/fourth"
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/registry"
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/stencil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLookup_allKeys(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	x := 0.5
+
+	keys := registry.Keys()
+	require.Len(t, keys, 4*3*4)
+
+	for _, key := range keys {
+		t.Run(key.String(), func(t *testing.T) {
+			method, err := registry.Lookup(key.Derivative, key.Philosophy, key.ErrorOrder)
+			require.NoError(t, err)
+
+			// Todas as derivadas de eˣ valem eˣ.
+			got, err := method.Calculate(ctx, math.Exp, x, 1e-2)
+			require.NoError(t, err)
+			assert.InEpsilon(t, math.Exp(x), got, 5e-2)
+		})
+	}
+}
+
+func TestLookup_returnsImplementation(t *testing.T) {
+	t.Parallel()
+
+	method, err := registry.Lookup(4, stencil.Central, 2)
+	require.NoError(t, err)
+	assert.IsType(t, &fourth.Central{}, method)
+}
+
+func TestLookup_unsupported(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		derivative uint64
+		philosophy stencil.Philosophy
+		errorOrder uint64
+	}{
+		{"DerivativeZero", 0, stencil.Central, 2},
+		{"DerivativeFive", 5, stencil.Central, 2},
+		{"ErrorOrderZero", 1, stencil.Forward, 0},
+		{"ErrorOrderFive", 2, stencil.Backward, 5},
+		{"HalfStep", 1, stencil.HalfStep, 2},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.NotPanics(t, func() {
+				_, err := registry.Lookup(tc.derivative, tc.philosophy, tc.errorOrder)
+				require.ErrorIs(t, err, registry.ErrUnsupported)
+			})
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package derivatives
+
+import "context"
+
+// Result é o resultado de uma derivada acompanhado de estimativas de erro.
+type Result struct {
+	// Value é o valor aproximado da derivada.
+	Value float64
+	// ErrorEstimate é a estimativa do erro de truncamento de Value.
+	ErrorEstimate float64
+	// RoundoffEstimate é a estimativa do erro de arredondamento (cancelamento) de Value.
+	RoundoffEstimate float64
+	// Points são as abscissas em que a função foi avaliada, sem repetição.
+	Points []float64
+	// Evaluations é o número de chamadas à função.
+	Evaluations int
+}
+
+// boundSafety é o fator de segurança aplicado à estimativa de truncamento em Bound.
+// A própria estimativa tem erro de ordem maior, que o fator cobre no regime assintótico.
+const boundSafety = 2
+
+// Bound retorna uma cota conservadora do erro: truncamento, com fator de segurança,
+// mais arredondamento.
+func (r Result) Bound() float64 {
+	return boundSafety*r.ErrorEstimate + r.RoundoffEstimate
+}
+
+// EstimatorInterface é uma DerivativeInterface capaz de estimar o próprio erro.
+type EstimatorInterface interface {
+	DerivativeInterface
+	Estimate(context.Context, Func, float64, float64) (Result, error)
+}
@@ -0,0 +1,150 @@
+package derivatives
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+)
+
+var _ DerivativeInterface = (*Richardson)(nil)
+
+// ErrToleranceNotReached indica que a extrapolação esgotou os níveis sem atingir a tolerância.
+var ErrToleranceNotReached = errors.New("derivatives: tolerância não atingida")
+
+const defaultRichardsonLevels = 10
+
+// Richardson envolve uma DerivativeInterface e aplica a extrapolação de Richardson,
+// avaliando a fórmula em h, h/2, h/4, ... até atingir a tolerância pedida.
+type Richardson struct {
+	// method é a fórmula de base.
+	method DerivativeInterface
+	// errorOrder é a ordem do termo dominante do erro da fórmula de base.
+	errorOrder uint64
+	// exponentStep é o incremento entre os expoentes do erro (1 em geral, 2 para fórmulas centrais simétricas).
+	exponentStep uint64
+	// tolerance é o erro estimado aceitável.
+	tolerance float64
+	// maxLevels é o número máximo de passos avaliados.
+	maxLevels int
+}
+
+// RichardsonResult é o resultado da extrapolação.
+type RichardsonResult struct {
+	// Value é o valor extrapolado da derivada.
+	Value float64
+	// ErrorEstimate é a estimativa do erro de Value.
+	ErrorEstimate float64
+	// Levels é a quantidade de passos (h, h/2, ...) avaliados.
+	Levels int
+}
+
+// RichardsonOption configura um Richardson.
+type RichardsonOption func(*Richardson)
+
+// WithMaxLevels define o número máximo de passos avaliados.
+func WithMaxLevels(levels int) RichardsonOption {
+	return func(r *Richardson) {
+		r.maxLevels = levels
+	}
+}
+
+// WithExponentStep define o incremento entre os expoentes da série do erro.
+// Fórmulas centrais simétricas têm apenas potências pares e usam 2.
+func WithExponentStep(step uint64) RichardsonOption {
+	return func(r *Richardson) {
+		r.exponentStep = step
+	}
+}
+
+// NewRichardson cria a extrapolação de method, cuja fórmula tem erro O(h^errorOrder).
+func NewRichardson(method DerivativeInterface, errorOrder uint64, tolerance float64, opts ...RichardsonOption) *Richardson {
+	if errorOrder == 0 {
+		panic(fmt.Sprintf("ordem de erro inválida para Richardson: %d", errorOrder))
+	}
+
+	r := &Richardson{
+		method:       method,
+		errorOrder:   errorOrder,
+		exponentStep: 1,
+		tolerance:    tolerance,
+		maxLevels:    defaultRichardsonLevels,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	if r.maxLevels < 2 {
+		panic(fmt.Sprintf("número de níveis inválido para Richardson: %d", r.maxLevels))
+	}
+	if r.exponentStep == 0 {
+		panic("incremento de expoente inválido para Richardson: 0")
+	}
+
+	return r
+}
+
+// Calculate retorna o valor extrapolado, satisfazendo DerivativeInterface.
+func (r *Richardson) Calculate(ctx context.Context, f Func, x, h float64) (float64, error) {
+	res, err := r.Extrapolate(ctx, f, x, h)
+	return res.Value, err
+}
+
+// Extrapolate monta a tabela de Richardson a partir do passo inicial h.
+// Se a tolerância não for atingida, retorna a melhor estimativa junto de ErrToleranceNotReached.
+func (r *Richardson) Extrapolate(ctx context.Context, f Func, x, h float64) (RichardsonResult, error) {
+	prev := make([]float64, 0, r.maxLevels)
+	curr := make([]float64, 0, r.maxLevels)
+
+	best := RichardsonResult{ErrorEstimate: math.Inf(1)}
+	step := h
+
+	for level := range r.maxLevels {
+		if err := ctx.Err(); err != nil {
+			return best, err
+		}
+
+		base, err := r.method.Calculate(ctx, f, x, step)
+		if err != nil {
+			return best, err
+		}
+
+		curr = append(curr[:0], base)
+		for j := 1; j <= level; j++ {
+			// Elimina o termo h^(p + (j-1)·s) da série do erro.
+			exponent := r.errorOrder + uint64(j-1)*r.exponentStep
+			factor := math.Pow(2, float64(exponent)) - 1
+			curr = append(curr, curr[j-1]+(curr[j-1]-prev[j-1])/factor)
+		}
+
+		if level == 0 {
+			best = RichardsonResult{Value: base, ErrorEstimate: math.Inf(1), Levels: 1}
+		} else {
+			diag := curr[level]
+			estimate := math.Max(math.Abs(diag-curr[level-1]), math.Abs(diag-prev[level-1]))
+
+			slog.DebugContext(ctx, "Nível de Richardson",
+				slog.Int("nivel", level+1),
+				slog.Float64("h", step),
+				slog.Float64("valor", diag),
+				slog.Float64("erro", estimate))
+
+			if estimate <= best.ErrorEstimate {
+				best = RichardsonResult{Value: diag, ErrorEstimate: estimate, Levels: level + 1}
+			}
+			if best.ErrorEstimate <= r.tolerance {
+				return best, nil
+			}
+			// O arredondamento passou a dominar: a tabela só tende a piorar.
+			if math.Abs(diag-prev[level-1]) >= 2*best.ErrorEstimate {
+				break
+			}
+		}
+
+		prev, curr = curr, prev
+		step /= 2
+	}
+
+	return best, fmt.Errorf("%w: erro estimado %g > %g", ErrToleranceNotReached, best.ErrorEstimate, r.tolerance)
+}
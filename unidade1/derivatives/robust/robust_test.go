@@ -0,0 +1,166 @@
+package robust_test
+
+import (
+	"context"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/robust"
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/tabulated"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// noisySignal amostra f em n pontos de [a, b] e soma ruído gaussiano de desvio sigma.
+func noisySignal(f func(float64) float64, a, b float64, n int, sigma float64) ([]float64, []float64, float64) {
+	rng := rand.New(rand.NewPCG(42, 7))
+	h := (b - a) / float64(n-1)
+
+	xs := make([]float64, n)
+	ys := make([]float64, n)
+	for i := range n {
+		xs[i] = a + float64(i)*h
+		ys[i] = f(xs[i]) + sigma*rng.NormFloat64()
+	}
+	return xs, ys, h
+}
+
+// rmsError retorna a raiz do erro quadrático médio entre got e df nos pontos xs.
+func rmsError(xs, got []float64, df func(float64) float64) float64 {
+	var sum float64
+	for i, x := range xs {
+		d := got[i] - df(x)
+		sum += d * d
+	}
+	return math.Sqrt(sum / float64(len(xs)))
+}
+
+func TestSavitzkyGolay_noisySignal(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	xs, ys, h := noisySignal(math.Sin, 0, 2*math.Pi, 400, 1e-3)
+
+	sg, err := robust.NewSavitzkyGolay(31, 3, 1)
+	require.NoError(t, err)
+	smooth, err := sg.Apply(ctx, ys, h)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		errorOrder uint64
+	}{
+		{"PlainO1", 1},
+		{"PlainO2", 2},
+		{"PlainO4", 4},
+	}
+
+	sgErr := rmsError(xs, smooth, math.Cos)
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			plain, err := tabulated.First(ctx, xs, ys, tc.errorOrder)
+			require.NoError(t, err)
+
+			plainErr := rmsError(xs, plain, math.Cos)
+			t.Logf("erro RMS: Savitzky–Golay=%.3e, estêncil O(h^%d)=%.3e", sgErr, tc.errorOrder, plainErr)
+
+			assert.Less(t, 5*sgErr, plainErr)
+		})
+	}
+}
+
+func TestSavitzkyGolay_exactForPolynomials(t *testing.T) {
+	t.Parallel()
+
+	h := 0.1
+	ys := make([]float64, 30)
+	for i := range ys {
+		x := float64(i) * h
+		ys[i] = x*x*x - 2*x
+	}
+
+	sg, err := robust.NewSavitzkyGolay(7, 3, 2)
+	require.NoError(t, err)
+	got, err := sg.Apply(context.Background(), ys, h)
+	require.NoError(t, err)
+
+	// Inclusive nas bordas, onde o polinômio é avaliado fora do centro da janela.
+	for i := range ys {
+		assert.InDelta(t, 6*float64(i)*h, got[i], 1e-9)
+	}
+
+	// Pesos clássicos da primeira derivada, janela 5, grau 2: (-2, -1, 0, 1, 2)/10.
+	sg, err = robust.NewSavitzkyGolay(5, 2, 1)
+	require.NoError(t, err)
+	assert.InDeltaSlice(t, []float64{-0.2, -0.1, 0, 0.1, 0.2}, sg.Coefficients(), 1e-12)
+}
+
+func TestTotalVariation_noisySignal(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// |x - 1| tem derivada constante por partes, com salto em x = 1.
+	f := func(x float64) float64 { return math.Abs(x - 1) }
+	df := func(x float64) float64 {
+		if x < 1 {
+			return -1
+		}
+		return 1
+	}
+	xs, ys, h := noisySignal(f, 0, 2, 200, 1e-2)
+
+	tv, err := robust.TotalVariation(ctx, ys, h, 1e-2, 30)
+	require.NoError(t, err)
+
+	plain, err := tabulated.First(ctx, xs, ys, 2)
+	require.NoError(t, err)
+
+	tvErr := rmsError(xs, tv, df)
+	plainErr := rmsError(xs, plain, df)
+	t.Logf("erro RMS: variação total=%.3e, estêncil O(h²)=%.3e", tvErr, plainErr)
+
+	assert.Less(t, 3*tvErr, plainErr)
+}
+
+func TestRobust_invalidInput(t *testing.T) {
+	t.Parallel()
+
+	_, err := robust.NewSavitzkyGolay(6, 2, 1)
+	assert.ErrorIs(t, err, robust.ErrInvalidWindow)
+
+	_, err = robust.NewSavitzkyGolay(3, 3, 1)
+	assert.ErrorIs(t, err, robust.ErrInvalidWindow)
+
+	_, err = robust.NewSavitzkyGolay(7, 1, 2)
+	assert.ErrorIs(t, err, robust.ErrInvalidDegree)
+
+	sg, err := robust.NewSavitzkyGolay(7, 2, 1)
+	require.NoError(t, err)
+	_, err = sg.Apply(context.Background(), []float64{1, 2, 3}, 0.1)
+	assert.ErrorIs(t, err, robust.ErrInvalidWindow)
+
+	for _, h := range []float64{0, -0.1, math.NaN()} {
+		_, err = sg.Apply(context.Background(), make([]float64, 10), h)
+		assert.ErrorIs(t, err, robust.ErrInvalidStep, "h=%g", h)
+	}
+
+	tvTests := []struct {
+		name       string
+		h, alpha   float64
+		iterations int
+	}{
+		{"PassoNulo", 0, 1, 10},
+		{"AlphaNulo", 0.1, 0, 10},
+		{"AlphaNegativo", 0.1, -1, 10},
+		{"AlphaNaN", 0.1, math.NaN(), 10},
+		{"SemIteracoes", 0.1, 1, 0},
+	}
+	for _, tc := range tvTests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := robust.TotalVariation(context.Background(), []float64{1, 2, 3}, tc.h, tc.alpha, tc.iterations)
+			assert.ErrorIs(t, err, robust.ErrInvalidRegularization)
+		})
+	}
+}
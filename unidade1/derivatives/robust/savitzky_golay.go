@@ -0,0 +1,141 @@
+// Package robust implementa derivadas resistentes a ruído para sinais amostrados com
+// espaçamento uniforme: o filtro de Savitzky–Golay e a diferenciação regularizada por
+// variação total.
+package robust
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+var (
+	// ErrInvalidWindow indica uma janela par, menor que o grau do polinômio ou maior que o sinal.
+	ErrInvalidWindow = errors.New("robust: janela inválida")
+	// ErrInvalidDegree indica um grau de polinômio menor que a ordem da derivada.
+	ErrInvalidDegree = errors.New("robust: grau do polinômio inválido")
+	// ErrInvalidStep indica um passo de amostragem nulo, negativo ou NaN.
+	ErrInvalidStep = errors.New("robust: passo de amostragem inválido")
+)
+
+// SavitzkyGolay deriva um sinal ajustando, por mínimos quadrados, um polinômio de grau
+// fixo em uma janela deslizante e derivando o polinômio. Nas bordas a janela é mantida
+// dentro do sinal e o polinômio é avaliado fora do centro.
+type SavitzkyGolay struct {
+	// half é a meia largura da janela (janela = 2·half + 1).
+	half int
+	// degree é o grau do polinômio ajustado.
+	degree int
+	// derivative é a ordem da derivada.
+	derivative int
+	// coefficients[t+half] são os pesos da derivada na posição t ∈ [-half, half] da janela,
+	// para passo unitário.
+	coefficients [][]float64
+}
+
+// NewSavitzkyGolay cria o filtro com janela ímpar window, polinômio de grau degree e
+// derivada de ordem derivative.
+func NewSavitzkyGolay(window, degree, derivative int) (*SavitzkyGolay, error) {
+	if window < 1 || window%2 == 0 {
+		return nil, fmt.Errorf("%w: a janela deve ser ímpar e positiva, recebido %d", ErrInvalidWindow, window)
+	}
+	if degree < derivative || derivative < 0 {
+		return nil, fmt.Errorf("%w: grau %d para derivada %d", ErrInvalidDegree, degree, derivative)
+	}
+	if window <= degree {
+		return nil, fmt.Errorf("%w: janela %d para polinômio de grau %d", ErrInvalidWindow, window, degree)
+	}
+
+	half := window / 2
+
+	// Matriz de Vandermonde A[i][k] = tᵢᵏ, tᵢ = i - half.
+	vander := mat.NewDense(window, degree+1, nil)
+	for i := range window {
+		t := float64(i - half)
+		for k := range degree + 1 {
+			vander.Set(i, k, math.Pow(t, float64(k)))
+		}
+	}
+
+	// P = (AᵀA)⁻¹Aᵀ: a linha k dá o coeficiente de tᵏ do polinômio ajustado.
+	var normal, pinv mat.Dense
+	normal.Mul(vander.T(), vander)
+	if err := pinv.Solve(&normal, vander.T()); err != nil {
+		return nil, fmt.Errorf("robust: sistema normal de Savitzky–Golay singular: %w", err)
+	}
+
+	coefficients := make([][]float64, window)
+	for pos := range window {
+		t := float64(pos - half)
+		row := make([]float64, window)
+		// dᵈ/dtᵈ Σ aₖtᵏ = Σ k!/(k-d)! aₖ tᵏ⁻ᵈ
+		for k := derivative; k <= degree; k++ {
+			factor := fallingFactorial(k, derivative) * math.Pow(t, float64(k-derivative))
+			for i := range window {
+				row[i] += factor * pinv.At(k, i)
+			}
+		}
+		coefficients[pos] = row
+	}
+
+	return &SavitzkyGolay{
+		half:         half,
+		degree:       degree,
+		derivative:   derivative,
+		coefficients: coefficients,
+	}, nil
+}
+
+// Apply deriva o sinal ys, amostrado com passo h. Retorna ErrInvalidStep se h não for positivo.
+func (sg *SavitzkyGolay) Apply(ctx context.Context, ys []float64, h float64) ([]float64, error) {
+	if !(h > 0) {
+		return nil, fmt.Errorf("%w: %g", ErrInvalidStep, h)
+	}
+	window := 2*sg.half + 1
+	if len(ys) < window {
+		return nil, fmt.Errorf("%w: janela %d para sinal de %d amostras", ErrInvalidWindow, window, len(ys))
+	}
+
+	slog.DebugContext(ctx, "Calculando a derivada de Savitzky–Golay",
+		slog.Int("janela", window),
+		slog.Int("grau", sg.degree),
+		slog.Int("derivada", sg.derivative),
+		slog.Int("amostras", len(ys)))
+
+	scale := math.Pow(h, float64(sg.derivative))
+	out := make([]float64, len(ys))
+	for i := range ys {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		// Início da janela, mantido dentro do sinal.
+		start := min(max(i-sg.half, 0), len(ys)-window)
+		weights := sg.coefficients[i-start]
+
+		var sum float64
+		for k, w := range weights {
+			sum += w * ys[start+k]
+		}
+		out[i] = sum / scale
+	}
+	return out, nil
+}
+
+// Coefficients retorna uma cópia dos pesos centrais (passo unitário) do filtro.
+func (sg *SavitzkyGolay) Coefficients() []float64 {
+	return append([]float64(nil), sg.coefficients[sg.half]...)
+}
+
+// fallingFactorial retorna k·(k-1)···(k-d+1).
+func fallingFactorial(k, d int) float64 {
+	out := 1.0
+	for i := range d {
+		out *= float64(k - i)
+	}
+	return out
+}
@@ -0,0 +1,107 @@
+package robust
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// tvEpsilon evita a divisão por zero no termo de variação total, |Du| ≈ √((Du)² + ε).
+const tvEpsilon = 1e-8
+
+// ErrInvalidRegularization indica parâmetros inválidos para a variação total.
+var ErrInvalidRegularization = errors.New("robust: parâmetros de regularização inválidos")
+
+// TotalVariation deriva o sinal ys, amostrado com passo h, pela diferenciação regularizada
+// por variação total (R. Chartrand, 2011). A derivada u minimiza
+//
+//	α·Σ|u[i+1] - u[i]| + ½·‖Au - (y - y₀)‖²
+//
+// em que A é a integral acumulada pela regra do trapézio. Penalizar a variação total
+// preserva descontinuidades da derivada sem amplificar o ruído. O problema não linear é
+// resolvido por difusividade defasada em iterations iterações.
+//
+// α deve ser positivo: sem a regularização, o sistema de cada iteração é singular.
+// Os sistemas são densos (N×N), adequados a sinais de até alguns milhares de amostras.
+func TotalVariation(ctx context.Context, ys []float64, h, alpha float64, iterations int) ([]float64, error) {
+	n := len(ys)
+	if n < 3 {
+		return nil, fmt.Errorf("%w: %d amostras", ErrInvalidRegularization, n)
+	}
+	if !(h > 0) || !(alpha > 0) || iterations < 1 {
+		return nil, fmt.Errorf("%w: h=%g, α=%g, iterações=%d", ErrInvalidRegularization, h, alpha, iterations)
+	}
+
+	slog.DebugContext(ctx, "Calculando a derivada regularizada por variação total",
+		slog.Int("amostras", n),
+		slog.Float64("alpha", alpha),
+		slog.Int("iteracoes", iterations))
+
+	integral := trapezoidIntegral(n, h)
+
+	var ata mat.Dense
+	ata.Mul(integral.T(), integral)
+
+	data := mat.NewVecDense(n, nil)
+	for i, y := range ys {
+		data.SetVec(i, y-ys[0])
+	}
+	var rhs mat.VecDense
+	rhs.MulVec(integral.T(), data)
+
+	// Chute inicial: diferenças centrais dos dados.
+	u := mat.NewVecDense(n, naiveDerivative(ys, h))
+
+	system := mat.NewDense(n, n, nil)
+	for range iterations {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		// L = h·Dᵀ·diag(1/√((Du)² + ε))·D, com D a diferença progressiva dividida por h.
+		system.Copy(&ata)
+		for i := range n - 1 {
+			du := (u.AtVec(i+1) - u.AtVec(i)) / h
+			w := alpha / (h * math.Sqrt(du*du+tvEpsilon))
+			system.Set(i, i, system.At(i, i)+w)
+			system.Set(i+1, i+1, system.At(i+1, i+1)+w)
+			system.Set(i, i+1, system.At(i, i+1)-w)
+			system.Set(i+1, i, system.At(i+1, i)-w)
+		}
+
+		if err := u.SolveVec(system, &rhs); err != nil {
+			return nil, fmt.Errorf("robust: sistema da variação total singular: %w", err)
+		}
+	}
+
+	return append([]float64(nil), u.RawVector().Data...), nil
+}
+
+// trapezoidIntegral monta a matriz A tal que (Au)[i] ≈ ∫ u de x₀ até xᵢ pela regra do trapézio.
+func trapezoidIntegral(n int, h float64) *mat.Dense {
+	a := mat.NewDense(n, n, nil)
+	for i := 1; i < n; i++ {
+		a.Set(i, 0, h/2)
+		for j := 1; j < i; j++ {
+			a.Set(i, j, h)
+		}
+		a.Set(i, i, h/2)
+	}
+	return a
+}
+
+// naiveDerivative retorna as diferenças centrais do sinal (unilaterais nas bordas).
+func naiveDerivative(ys []float64, h float64) []float64 {
+	n := len(ys)
+	out := make([]float64, n)
+	out[0] = (ys[1] - ys[0]) / h
+	out[n-1] = (ys[n-1] - ys[n-2]) / h
+	for i := 1; i < n-1; i++ {
+		out[i] = (ys[i+1] - ys[i-1]) / (2 * h)
+	}
+	return out
+}
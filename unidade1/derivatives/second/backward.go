@@ -6,14 +6,24 @@ import (
 	"log/slog"
 
 	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives"
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/stencil"
 )
 
-var _ derivatives.DerivativeInterface = (*Backward)(nil)
+var (
+	_ derivatives.EstimatorInterface = (*Backward)(nil)
+	_ stencil.Describer              = (*Backward)(nil)
+)
 
 // Backward é uma struct que calcula a segunda derivada pela filosofia regressiva.
 type Backward struct {
 	// formula armazena a função de cálculo específica (com base na ordem de erro).
 	formula func(ctx context.Context, f derivatives.Func, x, h float64) float64
+	// errorOrder é a ordem de erro da fórmula, usada no modo de passo automático.
+	errorOrder uint64
+	// reference é a fórmula gerada de ordem de erro maior, usada para estimar o erro.
+	reference *stencil.Stencil
+	// generated é a fórmula gerada com os mesmos coeficientes de formula, usada na introspecção.
+	generated *stencil.Stencil
 }
 
 func NewBackward(errorOrder uint64) *Backward {
@@ -33,15 +43,43 @@ func NewBackward(errorOrder uint64) *Backward {
 	}
 
 	return &Backward{
-		formula: selectedFormula,
+		formula:    selectedFormula,
+		errorOrder: errorOrder,
+		reference:  stencil.NewBackward(2, errorOrder+2),
+		generated:  stencil.NewBackward(2, errorOrder),
 	}
 }
 
 // Calculate executa o cálculo da derivada usando a fórmula que foi definida no NewBackward.
 func (b *Backward) Calculate(ctx context.Context, f derivatives.Func, x, h float64) (float64, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	h, err := derivatives.ResolveStep(ctx, b, f, x, h, 2, b.errorOrder)
+	if err != nil {
+		return 0, err
+	}
+
 	return b.formula(ctx, f, x, h), nil
 }
 
+// Estimate calcula a derivada e estima o erro de truncamento pela diferença para a
+// fórmula gerada com ordem de erro duas unidades maior.
+func (b *Backward) Estimate(ctx context.Context, f derivatives.Func, x, h float64) (derivatives.Result, error) {
+	h, err := derivatives.ResolveStep(ctx, b, f, x, h, 2, b.errorOrder)
+	if err != nil {
+		return derivatives.Result{}, err
+	}
+
+	return stencil.Estimate(ctx, b, b.reference, f, x, h)
+}
+
+// Describe retorna os deslocamentos, coeficientes, divisor e termo dominante do erro da fórmula.
+func (b *Backward) Describe() stencil.Description {
+	return b.generated.Describe()
+}
+
 func backwardOrder1(ctx context.Context, f derivatives.Func, x, h float64) float64 {
 	slog.DebugContext(ctx, "Calculando a derivada regressiva de ordem 1",
 		slog.Uint64("ordem", uint64(1)),
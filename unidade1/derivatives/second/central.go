@@ -6,14 +6,24 @@ import (
 	"log/slog"
 
 	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives"
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/stencil"
 )
 
-var _ derivatives.DerivativeInterface = (*Central)(nil)
+var (
+	_ derivatives.EstimatorInterface = (*Central)(nil)
+	_ stencil.Describer              = (*Central)(nil)
+)
 
 // Central é uma struct que calcula a segunda derivada pela filosofia central.
 type Central struct {
 	// formula armazena a função de cálculo específica (com base na ordem de erro).
 	formula func(ctx context.Context, f derivatives.Func, x, h float64) float64
+	// errorOrder é a ordem de erro da fórmula, usada no modo de passo automático.
+	errorOrder uint64
+	// reference é a fórmula gerada de ordem de erro maior, usada para estimar o erro.
+	reference *stencil.Stencil
+	// generated é a fórmula gerada com os mesmos coeficientes de formula, usada na introspecção.
+	generated *stencil.Stencil
 }
 
 func NewCentral(errorOrder uint64) *Central {
@@ -33,15 +43,43 @@ func NewCentral(errorOrder uint64) *Central {
 	}
 
 	return &Central{
-		formula: selectedFormula,
+		formula:    selectedFormula,
+		errorOrder: errorOrder,
+		reference:  stencil.NewCentral(2, errorOrder+2),
+		generated:  stencil.NewCentral(2, errorOrder),
 	}
 }
 
 // Calculate executa o cálculo da derivada usando a fórmula que foi definida no Newcentral.
 func (b *Central) Calculate(ctx context.Context, f derivatives.Func, x, h float64) (float64, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	h, err := derivatives.ResolveStep(ctx, b, f, x, h, 2, b.errorOrder)
+	if err != nil {
+		return 0, err
+	}
+
 	return b.formula(ctx, f, x, h), nil
 }
 
+// Estimate calcula a derivada e estima o erro de truncamento pela diferença para a
+// fórmula gerada com ordem de erro duas unidades maior.
+func (b *Central) Estimate(ctx context.Context, f derivatives.Func, x, h float64) (derivatives.Result, error) {
+	h, err := derivatives.ResolveStep(ctx, b, f, x, h, 2, b.errorOrder)
+	if err != nil {
+		return derivatives.Result{}, err
+	}
+
+	return stencil.Estimate(ctx, b, b.reference, f, x, h)
+}
+
+// Describe retorna os deslocamentos, coeficientes, divisor e termo dominante do erro da fórmula.
+func (b *Central) Describe() stencil.Description {
+	return b.generated.Describe()
+}
+
 func centralOrder1(ctx context.Context, f derivatives.Func, x, h float64) float64 {
 	slog.DebugContext(ctx, "Calculando a derivada central de ordem 1",
 		slog.Uint64("ordem", uint64(1)),
@@ -66,7 +104,7 @@ func centralOrder3(ctx context.Context, f derivatives.Func, x, h float64) float6
 		slog.Float64("x", x),
 		slog.Float64("h", h))
 
-	return (f(x-h) - 2*f(x) + f(x+h)) / (h * h)
+	return (-f(x-2*h) + 16*f(x-h) - 30*f(x) + 16*f(x+h) - f(x+2*h)) / (12 * h * h)
 }
 
 func centralOrder4(ctx context.Context, f derivatives.Func, x, h float64) float64 {
@@ -75,12 +113,12 @@ func centralOrder4(ctx context.Context, f derivatives.Func, x, h float64) float6
 		slog.Float64("x", x),
 		slog.Float64("h", h))
 
-	// 1/960 (-1239 A + 5695 B - 8950 C + 6030 D - 1795 E + 259 F)
-	numerador := -1239.0*f(x-2.5*h) +
-		5695.0*f(x-1.5*h) +
-		-8950.0*f(x-0.5*h) +
-		6030.0*f(x+0.5*h) +
-		-1795.0*f(x+1.5*h) +
-		259.0*f(x+2.5*h)
-	return numerador / (960.0 * h * h)
+	// 1/48 (-5 A + 39 B - 34 C - 34 D + 39 E - 5 F)
+	numerador := -5.0*f(x-2.5*h) +
+		39.0*f(x-1.5*h) +
+		-34.0*f(x-0.5*h) +
+		-34.0*f(x+0.5*h) +
+		39.0*f(x+1.5*h) +
+		-5.0*f(x+2.5*h)
+	return numerador / (48.0 * h * h)
 }
@@ -7,14 +7,24 @@ import (
 	"log/slog"
 
 	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives"
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/stencil"
 )
 
-var _ derivatives.DerivativeInterface = (*Forward)(nil)
+var (
+	_ derivatives.EstimatorInterface = (*Forward)(nil)
+	_ stencil.Describer              = (*Forward)(nil)
+)
 
 // Forward é uma struct que calcula a segunda derivada pela filosofia progressiva.
 type Forward struct {
 	// formula armazena a função de cálculo específica (com base na ordem de erro).
 	formula func(ctx context.Context, f derivatives.Func, x, h float64) float64
+	// errorOrder é a ordem de erro da fórmula, usada no modo de passo automático.
+	errorOrder uint64
+	// reference é a fórmula gerada de ordem de erro maior, usada para estimar o erro.
+	reference *stencil.Stencil
+	// generated é a fórmula gerada com os mesmos coeficientes de formula, usada na introspecção.
+	generated *stencil.Stencil
 }
 
 func NewForward(errorOrder uint64) *Forward {
@@ -34,15 +44,43 @@ func NewForward(errorOrder uint64) *Forward {
 	}
 
 	return &Forward{
-		formula: selectedFormula,
+		formula:    selectedFormula,
+		errorOrder: errorOrder,
+		reference:  stencil.NewForward(2, errorOrder+2),
+		generated:  stencil.NewForward(2, errorOrder),
 	}
 }
 
 // Calculate executa o cálculo da derivada usando a fórmula que foi definida no NewForward.
 func (b *Forward) Calculate(ctx context.Context, f derivatives.Func, x, h float64) (float64, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	h, err := derivatives.ResolveStep(ctx, b, f, x, h, 2, b.errorOrder)
+	if err != nil {
+		return 0, err
+	}
+
 	return b.formula(ctx, f, x, h), nil
 }
 
+// Estimate calcula a derivada e estima o erro de truncamento pela diferença para a
+// fórmula gerada com ordem de erro duas unidades maior.
+func (b *Forward) Estimate(ctx context.Context, f derivatives.Func, x, h float64) (derivatives.Result, error) {
+	h, err := derivatives.ResolveStep(ctx, b, f, x, h, 2, b.errorOrder)
+	if err != nil {
+		return derivatives.Result{}, err
+	}
+
+	return stencil.Estimate(ctx, b, b.reference, f, x, h)
+}
+
+// Describe retorna os deslocamentos, coeficientes, divisor e termo dominante do erro da fórmula.
+func (b *Forward) Describe() stencil.Description {
+	return b.generated.Describe()
+}
+
 func forwardOrder1(ctx context.Context, f derivatives.Func, x, h float64) float64 {
 	slog.DebugContext(ctx, "Calculando a derivada progressiva de ordem 1",
 		slog.Uint64("ordem", uint64(1)),
@@ -0,0 +1,203 @@
+// Package spectral implementa a diferenciação espectral: as derivadas de uma função suave
+// são obtidas derivando o seu interpolante polinomial nos pontos de Chebyshev ou, para dados
+// periódicos, o seu interpolante trigonométrico. O erro cai exponencialmente com o número
+// de pontos, em vez de O(hᵖ).
+package spectral
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives"
+	"gonum.org/v1/gonum/mat"
+)
+
+var (
+	// ErrInvalidSize indica uma quantidade de pontos insuficiente.
+	ErrInvalidSize = errors.New("spectral: quantidade de pontos inválida")
+	// ErrInvalidInterval indica um intervalo vazio ou invertido.
+	ErrInvalidInterval = errors.New("spectral: intervalo inválido")
+	// ErrLength indica amostras em quantidade diferente da de pontos.
+	ErrLength = errors.New("spectral: quantidade de amostras diferente da de pontos")
+)
+
+var _ derivatives.DerivativeInterface = (*Chebyshev)(nil)
+
+// Chebyshev é a matriz de diferenciação nos n+1 pontos de Chebyshev–Gauss–Lobatto de [a, b],
+// xⱼ = (a+b)/2 - (b-a)/2·cos(jπ/n), em ordem crescente.
+type Chebyshev struct {
+	// points são os pontos de Chebyshev em [a, b].
+	points []float64
+	// matrix é a matriz de diferenciação de primeira ordem.
+	matrix *mat.Dense
+}
+
+// ChebyshevPoints retorna os n+1 pontos de Chebyshev–Gauss–Lobatto de [a, b] em ordem crescente.
+func ChebyshevPoints(n int, a, b float64) []float64 {
+	points := make([]float64, n+1)
+	mid, half := (a+b)/2, (b-a)/2
+	for j := range points {
+		points[j] = mid - half*math.Cos(float64(j)*math.Pi/float64(n))
+	}
+	// Os extremos exatos evitam o arredondamento de cos(0) e cos(π).
+	points[0], points[n] = a, b
+	return points
+}
+
+// NewChebyshev monta a matriz de diferenciação de ordem (n+1)×(n+1) nos pontos de Chebyshev de [a, b].
+func NewChebyshev(n int, a, b float64) (*Chebyshev, error) {
+	if n < 1 {
+		return nil, fmt.Errorf("%w: n=%d, mínimo 1", ErrInvalidSize, n)
+	}
+	if !(b > a) {
+		return nil, fmt.Errorf("%w: [%g, %g]", ErrInvalidInterval, a, b)
+	}
+
+	// A matriz é montada em [-1, 1] e escalada para [a, b].
+	t := ChebyshevPoints(n, -1, 1)
+	scale := 2 / (b - a)
+
+	d := mat.NewDense(n+1, n+1, nil)
+	for i := range n + 1 {
+		var diagonal float64
+		for j := range n + 1 {
+			if i == j {
+				continue
+			}
+			// Dᵢⱼ = (cᵢ/cⱼ)·(-1)^(i+j)/(tᵢ - tⱼ), com c₀ = cₙ = 2 e cⱼ = 1 nos demais.
+			v := chebyshevWeight(i, n) / chebyshevWeight(j, n) / (t[i] - t[j])
+			if (i+j)%2 == 1 {
+				v = -v
+			}
+			d.Set(i, j, scale*v)
+			diagonal -= scale * v
+		}
+		// A diagonal pela soma negativa das linhas (derivada de constante nula) é mais
+		// precisa que a fórmula fechada.
+		d.Set(i, i, diagonal)
+	}
+
+	return &Chebyshev{
+		points: ChebyshevPoints(n, a, b),
+		matrix: d,
+	}, nil
+}
+
+// chebyshevWeight retorna cⱼ: 2 nos extremos e 1 nos pontos interiores.
+func chebyshevWeight(j, n int) float64 {
+	if j == 0 || j == n {
+		return 2
+	}
+	return 1
+}
+
+// Points retorna uma cópia dos pontos de Chebyshev.
+func (c *Chebyshev) Points() []float64 {
+	return append([]float64(nil), c.points...)
+}
+
+// Matrix retorna uma cópia da matriz de diferenciação de primeira ordem.
+func (c *Chebyshev) Matrix() *mat.Dense {
+	return mat.DenseCopyOf(c.matrix)
+}
+
+// Sample avalia f nos pontos de Chebyshev.
+func (c *Chebyshev) Sample(f derivatives.Func) []float64 {
+	return sample(f, c.points)
+}
+
+// Differentiate aplica order vezes a matriz às amostras ys, tomadas nos pontos de Chebyshev.
+func (c *Chebyshev) Differentiate(ctx context.Context, ys []float64, order int) ([]float64, error) {
+	slog.DebugContext(ctx, "Calculando a derivada espectral de Chebyshev",
+		slog.Int("pontos", len(c.points)),
+		slog.Int("ordem", order))
+
+	return apply(ctx, c.matrix, ys, order)
+}
+
+// Derivative amostra f nos pontos de Chebyshev e retorna a derivada de ordem order neles.
+func (c *Chebyshev) Derivative(ctx context.Context, f derivatives.Func, order int) ([]float64, error) {
+	return c.Differentiate(ctx, c.Sample(f), order)
+}
+
+// Calculate calcula a primeira derivada de f em x usando os pontos de Chebyshev do
+// intervalo [x-h, x+h], com a mesma quantidade de pontos desta matriz. Assim a fórmula
+// espectral pode ser comparada diretamente com as de diferenças finitas; ao contrário
+// delas, h não precisa ser pequeno, basta que f seja suave em [x-h, x+h].
+func (c *Chebyshev) Calculate(ctx context.Context, f derivatives.Func, x, h float64) (float64, error) {
+	if h == derivatives.AutoStep {
+		h = 1
+	}
+
+	n := len(c.points) - 1
+	local, err := NewChebyshev(n, x-h, x+h)
+	if err != nil {
+		return 0, err
+	}
+
+	d, err := local.Derivative(ctx, f, 1)
+	if err != nil {
+		return 0, err
+	}
+
+	if n%2 == 0 {
+		// Com n par o centro do intervalo é um dos pontos.
+		return d[n/2], nil
+	}
+	return local.interpolate(d, x), nil
+}
+
+// interpolate avalia em x o interpolante polinomial dos valores ys nos pontos de Chebyshev,
+// pela fórmula baricêntrica de segunda espécie.
+func (c *Chebyshev) interpolate(ys []float64, x float64) float64 {
+	n := len(c.points) - 1
+
+	var num, den float64
+	for j, xj := range c.points {
+		if x == xj {
+			return ys[j]
+		}
+		w := 1 / chebyshevWeight(j, n)
+		if j%2 == 1 {
+			w = -w
+		}
+		w /= x - xj
+		num += w * ys[j]
+		den += w
+	}
+	return num / den
+}
+
+// sample avalia f em cada ponto.
+func sample(f derivatives.Func, points []float64) []float64 {
+	ys := make([]float64, len(points))
+	for i, x := range points {
+		ys[i] = f(x)
+	}
+	return ys
+}
+
+// apply multiplica ys order vezes pela matriz d.
+func apply(ctx context.Context, d *mat.Dense, ys []float64, order int) ([]float64, error) {
+	n, _ := d.Dims()
+	if len(ys) != n {
+		return nil, fmt.Errorf("%w: %d amostras para %d pontos", ErrLength, len(ys), n)
+	}
+	if order < 0 {
+		return nil, fmt.Errorf("spectral: ordem da derivada inválida: %d", order)
+	}
+
+	v := mat.NewVecDense(n, append([]float64(nil), ys...))
+	var next mat.VecDense
+	for range order {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		next.MulVec(d, v)
+		v.CopyVec(&next)
+	}
+	return v.RawVector().Data, nil
+}
@@ -0,0 +1,97 @@
+package spectral
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives"
+	"gonum.org/v1/gonum/mat"
+)
+
+// Fourier é a matriz de diferenciação de funções periódicas nos n pontos igualmente
+// espaçados xⱼ = x₀ + j·L/n de um período [x₀, x₀ + L).
+type Fourier struct {
+	// points são os pontos da malha periódica.
+	points []float64
+	// matrix é a matriz de diferenciação de primeira ordem.
+	matrix *mat.Dense
+}
+
+// NewFourier monta a matriz de diferenciação n×n para funções de período period,
+// amostradas a partir de origin.
+func NewFourier(n int, origin, period float64) (*Fourier, error) {
+	if n < 2 {
+		return nil, fmt.Errorf("%w: n=%d, mínimo 2", ErrInvalidSize, n)
+	}
+	if !(period > 0) {
+		return nil, fmt.Errorf("%w: período %g", ErrInvalidInterval, period)
+	}
+
+	h := period / float64(n)
+	scale := 2 * math.Pi / period
+
+	points := make([]float64, n)
+	for j := range points {
+		points[j] = origin + float64(j)*h
+	}
+
+	// Para n par, Dᵢⱼ = ½(-1)^(i-j)·cot((i-j)π/n); para n ímpar, cossecante no lugar da
+	// cotangente. A diagonal é nula nos dois casos.
+	d := mat.NewDense(n, n, nil)
+	for i := range n {
+		for j := range n {
+			if i == j {
+				continue
+			}
+			angle := float64(i-j) * math.Pi / float64(n)
+			var v float64
+			if n%2 == 0 {
+				v = 0.5 / math.Tan(angle)
+			} else {
+				v = 0.5 / math.Sin(angle)
+			}
+			if (i-j)%2 != 0 {
+				v = -v
+			}
+			d.Set(i, j, scale*v)
+		}
+	}
+
+	return &Fourier{
+		points: points,
+		matrix: d,
+	}, nil
+}
+
+// Points retorna uma cópia dos pontos da malha.
+func (f *Fourier) Points() []float64 {
+	return append([]float64(nil), f.points...)
+}
+
+// Matrix retorna uma cópia da matriz de diferenciação de primeira ordem.
+func (f *Fourier) Matrix() *mat.Dense {
+	return mat.DenseCopyOf(f.matrix)
+}
+
+// Sample avalia fn nos pontos da malha.
+func (f *Fourier) Sample(fn derivatives.Func) []float64 {
+	return sample(fn, f.points)
+}
+
+// Differentiate aplica order vezes a matriz às amostras ys, tomadas nos pontos da malha.
+// Para n par, aplicar a matriz repetidamente descarta a frequência de Nyquist, o que só
+// importa se a função não estiver bem resolvida pela malha.
+func (f *Fourier) Differentiate(ctx context.Context, ys []float64, order int) ([]float64, error) {
+	slog.DebugContext(ctx, "Calculando a derivada espectral de Fourier",
+		slog.Int("pontos", len(f.points)),
+		slog.Int("ordem", order))
+
+	return apply(ctx, f.matrix, ys, order)
+}
+
+// Derivative amostra fn nos pontos da malha e retorna a derivada de ordem order neles.
+func (f *Fourier) Derivative(ctx context.Context, fn derivatives.Func, order int) ([]float64, error) {
+	return f.Differentiate(ctx, f.Sample(fn), order)
+}
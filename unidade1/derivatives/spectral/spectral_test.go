@@ -0,0 +1,166 @@
+package spectral_test
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/autodiff"
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/first"
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/spectral"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gonum.org/v1/gonum/mat"
+)
+
+func expSinTaylor(x autodiff.Taylor) autodiff.Taylor {
+	return x.Sin().Exp()
+}
+
+func expSin(x float64) float64 {
+	return math.Exp(math.Sin(x))
+}
+
+func TestChebyshev_smallMatrix(t *testing.T) {
+	t.Parallel()
+
+	// Com n = 1 a matriz deriva a reta pelos extremos de [-1, 1].
+	c, err := spectral.NewChebyshev(1, -1, 1)
+	require.NoError(t, err)
+
+	expected := mat.NewDense(2, 2, []float64{-0.5, 0.5, -0.5, 0.5})
+	assert.True(t, mat.EqualApprox(expected, c.Matrix(), 1e-15))
+	assert.Equal(t, []float64{-1, 1}, c.Points())
+}
+
+func TestChebyshev_derivatives(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		n         int
+		order     int
+		tolerance float64
+	}{
+		{"First", 32, 1, 1e-11},
+		{"Second", 32, 2, 1e-8},
+		{"Third", 32, 3, 1e-5},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			c, err := spectral.NewChebyshev(tc.n, -1, 2)
+			require.NoError(t, err)
+
+			got, err := c.Derivative(ctx, expSin, tc.order)
+			require.NoError(t, err)
+			require.Len(t, got, tc.n+1)
+
+			for i, x := range c.Points() {
+				expected := autodiff.NthDerivative(expSinTaylor, x, tc.order)
+				assert.InDeltaf(t, expected, got[i], tc.tolerance, "x=%g", x)
+			}
+		})
+	}
+}
+
+func TestChebyshev_exactForPolynomials(t *testing.T) {
+	t.Parallel()
+
+	// Polinômios de grau ≤ n são derivados exatamente.
+	c, err := spectral.NewChebyshev(5, 0, 3)
+	require.NoError(t, err)
+
+	got, err := c.Derivative(context.Background(), func(x float64) float64 {
+		return x*x*x*x*x - 2*x*x
+	}, 1)
+	require.NoError(t, err)
+
+	for i, x := range c.Points() {
+		assert.InDelta(t, 5*x*x*x*x-4*x, got[i], 1e-11)
+	}
+}
+
+func TestChebyshev_comparedWithCentral(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	x := 0.4
+	expected := autodiff.NthDerivative(expSinTaylor, x, 1)
+
+	for _, n := range []int{16, 17} {
+		c, err := spectral.NewChebyshev(n, -1, 1)
+		require.NoError(t, err)
+
+		// Com h = 0.5 a fórmula espectral ainda é exata à precisão de máquina, enquanto a
+		// central O(h⁴) precisa de um passo muito menor.
+		spectralValue, err := c.Calculate(ctx, expSin, x, 0.5)
+		require.NoError(t, err)
+		centralValue, err := first.NewCentral(4).Calculate(ctx, expSin, x, 1e-2)
+		require.NoError(t, err)
+
+		assert.InDeltaf(t, expected, spectralValue, 1e-12, "n=%d", n)
+		assert.Less(t, math.Abs(spectralValue-expected), math.Abs(centralValue-expected))
+	}
+}
+
+func TestFourier_derivatives(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	for _, n := range []int{32, 33} {
+		f, err := spectral.NewFourier(n, -math.Pi, 2*math.Pi)
+		require.NoError(t, err)
+
+		got, err := f.Derivative(ctx, expSin, 1)
+		require.NoError(t, err)
+		got2, err := f.Derivative(ctx, expSin, 2)
+		require.NoError(t, err)
+
+		for i, x := range f.Points() {
+			assert.InDeltaf(t, autodiff.NthDerivative(expSinTaylor, x, 1), got[i], 1e-12, "n=%d x=%g", n, x)
+			assert.InDeltaf(t, autodiff.NthDerivative(expSinTaylor, x, 2), got2[i], 1e-10, "n=%d x=%g", n, x)
+		}
+	}
+}
+
+func TestFourier_period(t *testing.T) {
+	t.Parallel()
+
+	// sen(2πx/3) tem período 3.
+	f, err := spectral.NewFourier(12, 0, 3)
+	require.NoError(t, err)
+
+	omega := 2 * math.Pi / 3
+	got, err := f.Derivative(context.Background(), func(x float64) float64 {
+		return math.Sin(omega * x)
+	}, 1)
+	require.NoError(t, err)
+
+	for i, x := range f.Points() {
+		assert.InDelta(t, omega*math.Cos(omega*x), got[i], 1e-13)
+	}
+}
+
+func TestSpectral_invalidInput(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	_, err := spectral.NewChebyshev(0, -1, 1)
+	require.ErrorIs(t, err, spectral.ErrInvalidSize)
+	_, err = spectral.NewChebyshev(4, 1, 1)
+	require.ErrorIs(t, err, spectral.ErrInvalidInterval)
+	_, err = spectral.NewFourier(1, 0, 1)
+	require.ErrorIs(t, err, spectral.ErrInvalidSize)
+	_, err = spectral.NewFourier(8, 0, -1)
+	require.ErrorIs(t, err, spectral.ErrInvalidInterval)
+
+	c, err := spectral.NewChebyshev(4, -1, 1)
+	require.NoError(t, err)
+	_, err = c.Differentiate(ctx, []float64{1, 2, 3}, 1)
+	require.ErrorIs(t, err, spectral.ErrLength)
+}
@@ -0,0 +1,158 @@
+package stencil
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Describer é implementado pelas fórmulas que expõem o próprio estêncil.
+type Describer interface {
+	Describe() Description
+}
+
+var _ Describer = (*Stencil)(nil)
+
+// Description descreve uma fórmula de diferenças finitas
+//
+//	f⁽ᵈ⁾(x) ≈ Σ cᵢ·f(x + oᵢh) / (divisor·hᵈ)
+//
+// e o termo dominante do seu erro de truncamento, aproximação - f⁽ᵈ⁾(x) ≈ C·hᵖ·f⁽ᵏ⁾(x).
+type Description struct {
+	// Derivative é a ordem d da derivada.
+	Derivative uint64
+	// Offsets são os deslocamentos oᵢ, em unidades de h.
+	Offsets []float64
+	// Coefficients são os coeficientes inteiros cᵢ.
+	Coefficients []float64
+	// Divisor é o menor denominador comum dos pesos.
+	Divisor float64
+	// ErrorOrder é a ordem p do termo dominante do erro.
+	ErrorOrder uint64
+	// LeadingCoefficient é a constante C do termo dominante do erro.
+	LeadingCoefficient float64
+	// LeadingDerivative é a ordem k = d + p da derivada no termo dominante do erro.
+	LeadingDerivative uint64
+}
+
+// Weights retorna os pesos cᵢ/divisor.
+func (d Description) Weights() []float64 {
+	w := make([]float64, len(d.Coefficients))
+	for i, c := range d.Coefficients {
+		w[i] = c / d.Divisor
+	}
+	return w
+}
+
+// String retorna a fórmula e o termo dominante do erro, por exemplo
+// "f⁽¹⁾(x) ≈ [-f(x) + f(x+h)] / (1·h^1); erro ≈ 0.5·h^1·f⁽²⁾(x)".
+func (d Description) String() string {
+	var sb strings.Builder
+	for i, c := range d.Coefficients {
+		if c == 0 {
+			continue
+		}
+
+		switch {
+		case sb.Len() == 0 && c < 0:
+			sb.WriteString("-")
+		case sb.Len() > 0 && c < 0:
+			sb.WriteString(" - ")
+		case sb.Len() > 0:
+			sb.WriteString(" + ")
+		}
+		if abs := max(c, -c); abs != 1 {
+			fmt.Fprintf(&sb, "%g·", abs)
+		}
+
+		switch o := d.Offsets[i]; {
+		case o == 0:
+			sb.WriteString("f(x)")
+		case o == 1:
+			sb.WriteString("f(x+h)")
+		case o == -1:
+			sb.WriteString("f(x-h)")
+		default:
+			fmt.Fprintf(&sb, "f(x%+gh)", o)
+		}
+	}
+
+	return fmt.Sprintf("f%s(x) ≈ [%s] / (%g·h^%d); erro ≈ %g·h^%d·f%s(x)",
+		superscript(d.Derivative), sb.String(), d.Divisor, d.Derivative,
+		d.LeadingCoefficient, d.ErrorOrder, superscript(d.LeadingDerivative))
+}
+
+// superscript retorna "⁽ⁿ⁾".
+func superscript(n uint64) string {
+	digits := []rune("⁰¹²³⁴⁵⁶⁷⁸⁹")
+
+	var sb strings.Builder
+	sb.WriteRune('⁽')
+	for _, r := range fmt.Sprint(n) {
+		sb.WriteRune(digits[r-'0'])
+	}
+	sb.WriteRune('⁾')
+	return sb.String()
+}
+
+// Describe retorna os deslocamentos, os coeficientes inteiros, o divisor e o termo
+// dominante do erro de truncamento do estêncil, calculados em aritmética exata.
+func (s *Stencil) Describe() Description {
+	// O divisor é o mínimo múltiplo comum dos denominadores dos pesos.
+	divisor := big.NewInt(1)
+	gcd := new(big.Int)
+	for _, w := range s.exact {
+		den := w.Denom()
+		gcd.GCD(nil, nil, divisor, den)
+		divisor.Mul(divisor, new(big.Int).Quo(den, gcd))
+	}
+
+	coefficients := make([]float64, len(s.exact))
+	for i, w := range s.exact {
+		c := new(big.Rat).Mul(w, new(big.Rat).SetInt(divisor))
+		coefficients[i], _ = c.Float64()
+	}
+	div, _ := new(big.Rat).SetInt(divisor).Float64()
+
+	desc := Description{
+		Derivative:   s.derivative,
+		Offsets:      s.Offsets(),
+		Coefficients: coefficients,
+		Divisor:      div,
+	}
+
+	// Pela série de Taylor, Σ wᵢ f(x + oᵢh)/hᵈ = Σₖ Mₖ/k!·hᵏ⁻ᵈ·f⁽ᵏ⁾(x), com Mₖ = Σ wᵢoᵢᵏ.
+	// O estêncil zera Mₖ para k < len(offsets), exceto Mᵈ = d!; o termo dominante do erro
+	// é o primeiro Mₖ não nulo com k > d.
+	nodes := make([]*big.Rat, len(s.offsets))
+	for i, o := range s.offsets {
+		nodes[i] = new(big.Rat).SetFloat64(o)
+	}
+	factorial := big.NewRat(1, 1)
+	for k := uint64(1); k <= s.derivative+2*uint64(len(nodes)); k++ {
+		factorial.Mul(factorial, new(big.Rat).SetInt64(int64(k)))
+		if k <= s.derivative {
+			continue
+		}
+
+		moment := new(big.Rat)
+		power := new(big.Rat)
+		for i, w := range s.exact {
+			power.SetInt64(1)
+			for range k {
+				power.Mul(power, nodes[i])
+			}
+			moment.Add(moment, power.Mul(power, w))
+		}
+		if moment.Sign() == 0 {
+			continue
+		}
+
+		desc.LeadingCoefficient, _ = moment.Quo(moment, factorial).Float64()
+		desc.LeadingDerivative = k
+		desc.ErrorOrder = k - s.derivative
+		break
+	}
+
+	return desc
+}
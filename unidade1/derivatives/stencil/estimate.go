@@ -0,0 +1,141 @@
+package stencil
+
+import (
+	"context"
+	"math"
+	"slices"
+
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives"
+)
+
+var _ derivatives.EstimatorInterface = (*Stencil)(nil)
+
+// epsilon é o épsilon de máquina do float64.
+const epsilon = 0x1p-52
+
+// recorder envolve uma Func registrando os pontos avaliados.
+type recorder struct {
+	f           derivatives.Func
+	points      []float64
+	evaluations int
+	maxAbs      float64
+}
+
+func (r *recorder) eval(x float64) float64 {
+	r.evaluations++
+	if !slices.Contains(r.points, x) {
+		r.points = append(r.points, x)
+	}
+
+	y := r.f(x)
+	r.maxAbs = math.Max(r.maxAbs, math.Abs(y))
+	return y
+}
+
+// Estimate calcula a derivada com method e estima seu erro de truncamento pela diferença
+// para reference, uma fórmula de ordem de erro maior sobre os mesmos passos.
+func Estimate(ctx context.Context, method derivatives.DerivativeInterface, reference *Stencil, f derivatives.Func, x, h float64) (derivatives.Result, error) {
+	rec := &recorder{f: f}
+
+	value, err := method.Calculate(ctx, rec.eval, x, h)
+	if err != nil {
+		return derivatives.Result{}, err
+	}
+
+	refValue, err := reference.Calculate(ctx, rec.eval, x, h)
+	if err != nil {
+		return derivatives.Result{}, err
+	}
+
+	return derivatives.Result{
+		Value:            value,
+		ErrorEstimate:    math.Abs(value - refValue),
+		RoundoffEstimate: reference.roundoff(rec.maxAbs, h),
+		Points:           rec.points,
+		Evaluations:      rec.evaluations,
+	}, nil
+}
+
+// Estimate calcula a derivada e estima o erro de truncamento pela fórmula embutida de
+// ordem menor, obtida descartando o ponto mais distante de x. Os valores de f são
+// reaproveitados, então não há avaliações extras. Um estêncil com o mínimo de pontos
+// não tem fórmula embutida e reporta erro de truncamento infinito.
+func (s *Stencil) Estimate(ctx context.Context, f derivatives.Func, x, h float64) (derivatives.Result, error) {
+	h, err := derivatives.ResolveStep(ctx, s, f, x, h, s.derivative, uint64(len(s.offsets))-s.derivative)
+	if err != nil {
+		return derivatives.Result{}, err
+	}
+
+	rec := &recorder{f: f}
+	values := make([]float64, len(s.offsets))
+	for i, o := range s.offsets {
+		values[i] = rec.eval(x + o*h)
+	}
+
+	scale := math.Pow(h, float64(s.derivative))
+	value := dot(s.weights, values) / scale
+
+	truncation := math.Inf(1)
+	if embedded, keep := s.embedded(); embedded != nil {
+		lower := make([]float64, 0, len(keep))
+		for _, i := range keep {
+			lower = append(lower, values[i])
+		}
+		truncation = math.Abs(value - dot(embedded.weights, lower)/scale)
+	}
+
+	return derivatives.Result{
+		Value:            value,
+		ErrorEstimate:    truncation,
+		RoundoffEstimate: s.roundoff(rec.maxAbs, h),
+		Points:           rec.points,
+		Evaluations:      rec.evaluations,
+	}, ctx.Err()
+}
+
+// embedded retorna a fórmula de ordem menor sem o ponto mais distante de zero e os
+// índices dos pontos mantidos.
+func (s *Stencil) embedded() (*Stencil, []int) {
+	if uint64(len(s.offsets)) <= s.derivative+1 {
+		return nil, nil
+	}
+
+	farthest := 0
+	for i, o := range s.offsets {
+		if math.Abs(o) > math.Abs(s.offsets[farthest]) {
+			farthest = i
+		}
+	}
+
+	keep := make([]int, 0, len(s.offsets)-1)
+	offsets := make([]float64, 0, len(s.offsets)-1)
+	for i, o := range s.offsets {
+		if i != farthest {
+			keep = append(keep, i)
+			offsets = append(offsets, o)
+		}
+	}
+
+	lower, err := New(s.derivative, offsets)
+	if err != nil {
+		return nil, nil
+	}
+	return lower, keep
+}
+
+// roundoff estima o erro de arredondamento ε·max|f|·Σ|wᵢ| / hᵈ.
+func (s *Stencil) roundoff(maxAbsF, h float64) float64 {
+	var sum float64
+	for _, w := range s.weights {
+		sum += math.Abs(w)
+	}
+	return epsilon * maxAbsF * sum / math.Pow(math.Abs(h), float64(s.derivative))
+}
+
+func dot(a, b []float64) float64 {
+	var sum float64
+	for i := range a {
+		sum += a[i] * b[i]
+	}
+	return sum
+}
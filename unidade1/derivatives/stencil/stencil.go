@@ -0,0 +1,373 @@
+// Package stencil gera fórmulas de diferenças finitas para qualquer ordem de derivada,
+// ordem de erro e conjunto de deslocamentos, usando o algoritmo de Fornberg.
+package stencil
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"math/big"
+
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives"
+)
+
+var _ derivatives.DerivativeInterface = (*Stencil)(nil)
+
+// Philosophy identifica a disposição dos pontos do estêncil em torno de x.
+type Philosophy int
+
+const (
+	// Forward usa os pontos x, x+h, x+2h, ...
+	Forward Philosophy = iota
+	// Backward usa os pontos x, x-h, x-2h, ...
+	Backward
+	// Central usa pontos simétricos em torno de x; com quantidade par de pontos
+	// os deslocamentos são meio-passos (±h/2, ±3h/2, ...).
+	Central
+	// HalfStep usa sempre pontos simétricos em meio-passo.
+	HalfStep
+)
+
+// String retorna o nome da filosofia.
+func (p Philosophy) String() string {
+	switch p {
+	case Forward:
+		return "progressiva"
+	case Backward:
+		return "regressiva"
+	case Central:
+		return "central"
+	case HalfStep:
+		return "meio-passo"
+	default:
+		return fmt.Sprintf("Philosophy(%d)", int(p))
+	}
+}
+
+var (
+	// ErrNotEnoughPoints indica que o estêncil não tem pontos suficientes para a derivada pedida.
+	ErrNotEnoughPoints = errors.New("stencil: pontos insuficientes para a ordem de derivada")
+	// ErrDuplicateOffset indica deslocamentos repetidos no estêncil.
+	ErrDuplicateOffset = errors.New("stencil: deslocamento repetido")
+	// ErrInvalidOffset indica um deslocamento não finito (NaN ou ±Inf).
+	ErrInvalidOffset = errors.New("stencil: deslocamento inválido")
+)
+
+// Stencil é uma fórmula de diferenças finitas f⁽ᵈ⁾(x) ≈ Σ wᵢ f(x + oᵢh) / hᵈ.
+type Stencil struct {
+	// derivative é a ordem da derivada aproximada.
+	derivative uint64
+	// offsets são os deslocamentos oᵢ em unidades de h.
+	offsets []float64
+	// weights são os pesos wᵢ, em ponto flutuante.
+	weights []float64
+	// exact guarda os pesos exatos, como racionais.
+	exact []*big.Rat
+}
+
+// New gera o estêncil para a derivada de ordem derivative usando os deslocamentos
+// (em unidades de h) informados. Os pesos são os únicos que tornam a fórmula exata
+// para polinômios de grau len(offsets)-1.
+func New(derivative uint64, offsets []float64) (*Stencil, error) {
+	if uint64(len(offsets)) < derivative+1 {
+		return nil, fmt.Errorf("%w: derivada %d requer ao menos %d pontos, recebido %d",
+			ErrNotEnoughPoints, derivative, derivative+1, len(offsets))
+	}
+
+	nodes := make([]*big.Rat, len(offsets))
+	for i, o := range offsets {
+		if math.IsNaN(o) || math.IsInf(o, 0) {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidOffset, o)
+		}
+		for _, prev := range offsets[:i] {
+			if prev == o {
+				return nil, fmt.Errorf("%w: %v", ErrDuplicateOffset, o)
+			}
+		}
+		nodes[i] = new(big.Rat).SetFloat64(o)
+	}
+
+	exact := fornberg(derivative, nodes)
+	weights := make([]float64, len(exact))
+	for i, w := range exact {
+		weights[i], _ = w.Float64()
+	}
+
+	return &Stencil{
+		derivative: derivative,
+		offsets:    append([]float64(nil), offsets...),
+		weights:    weights,
+		exact:      exact,
+	}, nil
+}
+
+// NewForward gera o estêncil progressivo da derivada de ordem derivative com erro O(h^errorOrder).
+func NewForward(derivative, errorOrder uint64) *Stencil {
+	return mustPhilosophy(Forward, derivative, errorOrder)
+}
+
+// NewBackward gera o estêncil regressivo da derivada de ordem derivative com erro O(h^errorOrder).
+func NewBackward(derivative, errorOrder uint64) *Stencil {
+	return mustPhilosophy(Backward, derivative, errorOrder)
+}
+
+// NewCentral gera o estêncil central da derivada de ordem derivative com erro O(h^errorOrder).
+func NewCentral(derivative, errorOrder uint64) *Stencil {
+	return mustPhilosophy(Central, derivative, errorOrder)
+}
+
+// NewHalfStep gera o estêncil central em meio-passo da derivada de ordem derivative.
+func NewHalfStep(derivative, errorOrder uint64) *Stencil {
+	return mustPhilosophy(HalfStep, derivative, errorOrder)
+}
+
+// NewPhilosophy gera o estêncil da filosofia informada, retornando erro em vez de pânico.
+func NewPhilosophy(p Philosophy, derivative, errorOrder uint64) (*Stencil, error) {
+	if derivative == 0 {
+		return nil, fmt.Errorf("stencil: ordem de derivada inválida: %d", derivative)
+	}
+	if errorOrder == 0 {
+		return nil, fmt.Errorf("stencil: ordem de erro inválida para derivada %s: %d", p, errorOrder)
+	}
+
+	offsets, err := Offsets(p, int(derivative+errorOrder))
+	if err != nil {
+		return nil, err
+	}
+	return New(derivative, offsets)
+}
+
+func mustPhilosophy(p Philosophy, derivative, errorOrder uint64) *Stencil {
+	s, err := NewPhilosophy(p, derivative, errorOrder)
+	if err != nil {
+		panic(err.Error())
+	}
+	return s
+}
+
+// Offsets retorna os n deslocamentos (em unidades de h) da filosofia p.
+// Segue a convenção dos pacotes first, second e third: uma fórmula de derivada d
+// com erro O(hᵖ) usa n = d + p pontos.
+func Offsets(p Philosophy, n int) ([]float64, error) {
+	if n <= 0 {
+		return nil, fmt.Errorf("%w: %d pontos", ErrNotEnoughPoints, n)
+	}
+
+	offsets := make([]float64, n)
+	switch p {
+	case Forward:
+		for i := range n {
+			offsets[i] = float64(i)
+		}
+	case Backward:
+		for i := range n {
+			offsets[i] = float64(i - n + 1)
+		}
+	case Central:
+		for i := range n {
+			offsets[i] = float64(i) - float64(n-1)/2
+		}
+	case HalfStep:
+		if n%2 == 1 {
+			n++
+			offsets = make([]float64, n)
+		}
+		for i := range n {
+			offsets[i] = float64(i) - float64(n-1)/2
+		}
+	default:
+		return nil, fmt.Errorf("stencil: filosofia desconhecida: %s", p)
+	}
+	return offsets, nil
+}
+
+// CentralPoints retorna a menor quantidade ímpar de pontos de um estêncil central da
+// derivada de ordem derivative com erro de ordem pelo menos errorOrder, para que os pontos
+// caiam sobre a malha (Offsets(Central, n) com n ímpar). Por simetria, n pontos dão erro
+// O(h^(n-d)) se n-d for par e O(h^(n-d+1)) se for ímpar, então a ordem obtida pode ser maior
+// que a pedida. Ao contrário de NewCentral, que usa d + p pontos, nunca gera meio-passos.
+func CentralPoints(derivative, errorOrder uint64) int {
+	n := derivative + 1
+	if n%2 == 0 {
+		n++
+	}
+	for order := n - derivative + (n-derivative)%2; order < errorOrder; order += 2 {
+		n += 2
+	}
+	return int(n)
+}
+
+// Calculate aplica o estêncil em f no ponto x com passo h.
+// Com h igual a derivatives.AutoStep, a ordem de erro é a quantidade de pontos menos a ordem da derivada;
+// o modo automático não se aplica a estênceis de interpolação (derivada 0).
+func (s *Stencil) Calculate(ctx context.Context, f derivatives.Func, x, h float64) (float64, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	h, err := derivatives.ResolveStep(ctx, s, f, x, h, s.derivative, uint64(len(s.offsets))-s.derivative)
+	if err != nil {
+		return 0, err
+	}
+
+	slog.DebugContext(ctx, "Calculando a derivada pelo estêncil gerado",
+		slog.Uint64("derivada", s.derivative),
+		slog.Int("pontos", len(s.offsets)),
+		slog.Float64("x", x),
+		slog.Float64("h", h))
+
+	var sum float64
+	for i, o := range s.offsets {
+		if s.weights[i] == 0 {
+			continue
+		}
+		sum += s.weights[i] * f(x+o*h)
+	}
+
+	return sum / math.Pow(h, float64(s.derivative)), nil
+}
+
+// Derivative retorna a ordem da derivada aproximada pelo estêncil.
+func (s *Stencil) Derivative() uint64 {
+	return s.derivative
+}
+
+// Offsets retorna uma cópia dos deslocamentos, em unidades de h.
+func (s *Stencil) Offsets() []float64 {
+	return append([]float64(nil), s.offsets...)
+}
+
+// Weights retorna uma cópia dos pesos do estêncil.
+func (s *Stencil) Weights() []float64 {
+	return append([]float64(nil), s.weights...)
+}
+
+// fornberg calcula, em aritmética racional exata, os pesos da derivada de ordem m
+// no ponto 0 para os nós informados (B. Fornberg, Math. Comp. 51, 1988).
+func fornberg(m uint64, nodes []*big.Rat) []*big.Rat {
+	n := len(nodes)
+	mm := int(m)
+
+	// c[i][k] é o peso do nó i para a derivada de ordem k.
+	c := make([][]*big.Rat, n)
+	for i := range c {
+		c[i] = make([]*big.Rat, mm+1)
+		for k := range c[i] {
+			c[i][k] = new(big.Rat)
+		}
+	}
+	c[0][0].SetInt64(1)
+
+	c1 := big.NewRat(1, 1)
+	c4 := new(big.Rat).Set(nodes[0])
+	tmp := new(big.Rat)
+
+	for i := 1; i < n; i++ {
+		mn := min(i, mm)
+		c2 := big.NewRat(1, 1)
+		c5 := new(big.Rat).Set(c4)
+		c4 = new(big.Rat).Set(nodes[i])
+
+		for j := range i {
+			c3 := new(big.Rat).Sub(nodes[i], nodes[j])
+			c2.Mul(c2, c3)
+
+			if j == i-1 {
+				for k := mn; k > 0; k-- {
+					// c[i][k] = c1*(k*c[i-1][k-1] - c5*c[i-1][k]) / c2
+					v := new(big.Rat).Mul(big.NewRat(int64(k), 1), c[i-1][k-1])
+					v.Sub(v, tmp.Mul(c5, c[i-1][k]))
+					v.Mul(v, c1)
+					c[i][k] = v.Quo(v, c2)
+				}
+				v := new(big.Rat).Mul(c1, c5)
+				v.Mul(v, c[i-1][0])
+				v.Neg(v)
+				c[i][0] = v.Quo(v, c2)
+			}
+
+			for k := mn; k > 0; k-- {
+				// c[j][k] = (c4*c[j][k] - k*c[j][k-1]) / c3
+				v := new(big.Rat).Mul(c4, c[j][k])
+				v.Sub(v, tmp.Mul(big.NewRat(int64(k), 1), c[j][k-1]))
+				c[j][k] = v.Quo(v, c3)
+			}
+			v := new(big.Rat).Mul(c4, c[j][0])
+			c[j][0] = v.Quo(v, c3)
+		}
+		c1 = c2
+	}
+
+	weights := make([]*big.Rat, n)
+	for i := range n {
+		weights[i] = c[i][mm]
+	}
+	return weights
+}
+
+// Weights calcula, em ponto flutuante, os pesos da derivada de ordem derivative no ponto
+// x0 para nós arbitrários (não necessariamente igualmente espaçados). Diferentemente de
+// New, os nós são abscissas absolutas e os pesos já incluem o espaçamento.
+func Weights(derivative uint64, x0 float64, nodes []float64) ([]float64, error) {
+	if derivative == 0 {
+		return nil, fmt.Errorf("stencil: ordem de derivada inválida: %d", derivative)
+	}
+	if uint64(len(nodes)) < derivative+1 {
+		return nil, fmt.Errorf("%w: derivada %d requer ao menos %d pontos, recebido %d",
+			ErrNotEnoughPoints, derivative, derivative+1, len(nodes))
+	}
+	for i, x := range nodes {
+		if math.IsNaN(x) || math.IsInf(x, 0) {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidOffset, x)
+		}
+		for _, prev := range nodes[:i] {
+			if prev == x {
+				return nil, fmt.Errorf("%w: %v", ErrDuplicateOffset, x)
+			}
+		}
+	}
+
+	n := len(nodes)
+	m := int(derivative)
+
+	c := make([][]float64, n)
+	for i := range c {
+		c[i] = make([]float64, m+1)
+	}
+	c[0][0] = 1
+
+	c1 := 1.0
+	c4 := nodes[0] - x0
+	for i := 1; i < n; i++ {
+		mn := min(i, m)
+		c2 := 1.0
+		c5 := c4
+		c4 = nodes[i] - x0
+
+		for j := range i {
+			c3 := nodes[i] - nodes[j]
+			c2 *= c3
+
+			if j == i-1 {
+				for k := mn; k > 0; k-- {
+					c[i][k] = c1 * (float64(k)*c[i-1][k-1] - c5*c[i-1][k]) / c2
+				}
+				c[i][0] = -c1 * c5 * c[i-1][0] / c2
+			}
+
+			for k := mn; k > 0; k-- {
+				c[j][k] = (c4*c[j][k] - float64(k)*c[j][k-1]) / c3
+			}
+			c[j][0] = c4 * c[j][0] / c3
+		}
+		c1 = c2
+	}
+
+	weights := make([]float64, n)
+	for i := range n {
+		weights[i] = c[i][m]
+	}
+	return weights, nil
+}
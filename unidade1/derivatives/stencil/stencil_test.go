@@ -0,0 +1,434 @@
+package stencil_test
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives"
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/first"
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/fourth"
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/registry"
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/second"
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/stencil"
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/third"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// handWritten são as fórmulas escritas à mão nos pacotes first, second, third e fourth.
+// O gerador precisa reproduzir exatamente os seus coeficientes.
+var handWritten = []struct {
+	name         string
+	stencil      *stencil.Stencil
+	method       derivatives.DerivativeInterface
+	coefficients []float64
+	divisor      float64
+}{
+	{"FirstForwardO1", stencil.NewForward(1, 1), first.NewForward(1), []float64{-1, 1}, 1},
+	{"FirstForwardO2", stencil.NewForward(1, 2), first.NewForward(2), []float64{-3, 4, -1}, 2},
+	{"FirstForwardO3", stencil.NewForward(1, 3), first.NewForward(3), []float64{-11, 18, -9, 2}, 6},
+	{"FirstForwardO4", stencil.NewForward(1, 4), first.NewForward(4), []float64{-25, 48, -36, 16, -3}, 12},
+	{"FirstBackwardO1", stencil.NewBackward(1, 1), first.NewBackward(1), []float64{-1, 1}, 1},
+	{"FirstBackwardO2", stencil.NewBackward(1, 2), first.NewBackward(2), []float64{1, -4, 3}, 2},
+	{"FirstBackwardO3", stencil.NewBackward(1, 3), first.NewBackward(3), []float64{-2, 9, -18, 11}, 6},
+	{"FirstBackwardO4", stencil.NewBackward(1, 4), first.NewBackward(4), []float64{3, -16, 36, -48, 25}, 12},
+	{"FirstCentralO1", stencil.NewCentral(1, 1), first.NewCentral(1), []float64{-1, 1}, 1},
+	{"FirstCentralO2", stencil.NewCentral(1, 2), first.NewCentral(2), []float64{-1, 0, 1}, 2},
+	{"FirstCentralO3", stencil.NewCentral(1, 3), first.NewCentral(3), []float64{1, -27, 27, -1}, 24},
+	{"FirstCentralO4", stencil.NewCentral(1, 4), first.NewCentral(4), []float64{1, -8, 0, 8, -1}, 12},
+	{"SecondForwardO1", stencil.NewForward(2, 1), second.NewForward(1), []float64{1, -2, 1}, 1},
+	{"SecondForwardO2", stencil.NewForward(2, 2), second.NewForward(2), []float64{2, -5, 4, -1}, 1},
+	{"SecondForwardO3", stencil.NewForward(2, 3), second.NewForward(3), []float64{35, -104, 114, -56, 11}, 12},
+	{"SecondForwardO4", stencil.NewForward(2, 4), second.NewForward(4), []float64{45, -154, 214, -156, 61, -10}, 12},
+	{"SecondBackwardO1", stencil.NewBackward(2, 1), second.NewBackward(1), []float64{1, -2, 1}, 1},
+	{"SecondBackwardO2", stencil.NewBackward(2, 2), second.NewBackward(2), []float64{-1, 4, -5, 2}, 1},
+	{"SecondBackwardO3", stencil.NewBackward(2, 3), second.NewBackward(3), []float64{11, -56, 114, -104, 35}, 12},
+	{"SecondBackwardO4", stencil.NewBackward(2, 4), second.NewBackward(4), []float64{-10, 61, -156, 214, -154, 45}, 12},
+	{"SecondCentralO1", stencil.NewCentral(2, 1), second.NewCentral(1), []float64{1, -2, 1}, 1},
+	{"SecondCentralO2", stencil.NewCentral(2, 2), second.NewCentral(2), []float64{1, -1, -1, 1}, 2},
+	{"SecondCentralO3", stencil.NewCentral(2, 3), second.NewCentral(3), []float64{-1, 16, -30, 16, -1}, 12},
+	{"SecondCentralO4", stencil.NewCentral(2, 4), second.NewCentral(4), []float64{-5, 39, -34, -34, 39, -5}, 48},
+	{"ThirdForwardO1", stencil.NewForward(3, 1), third.NewForward(1), []float64{-1, 3, -3, 1}, 1},
+	{"ThirdForwardO2", stencil.NewForward(3, 2), third.NewForward(2), []float64{-5, 18, -24, 14, -3}, 2},
+	{"ThirdForwardO3", stencil.NewForward(3, 3), third.NewForward(3), []float64{-17, 71, -118, 98, -41, 7}, 4},
+	{"ThirdForwardO4", stencil.NewForward(3, 4), third.NewForward(4), []float64{-49, 232, -461, 496, -307, 104, -15}, 8},
+	{"ThirdBackwardO1", stencil.NewBackward(3, 1), third.NewBackward(1), []float64{-1, 3, -3, 1}, 1},
+	{"ThirdBackwardO2", stencil.NewBackward(3, 2), third.NewBackward(2), []float64{3, -14, 24, -18, 5}, 2},
+	{"ThirdBackwardO3", stencil.NewBackward(3, 3), third.NewBackward(3), []float64{-7, 41, -98, 118, -71, 17}, 4},
+	{"ThirdBackwardO4", stencil.NewBackward(3, 4), third.NewBackward(4), []float64{15, -104, 307, -496, 461, -232, 49}, 8},
+	{"ThirdCentralO1", stencil.NewCentral(3, 1), third.NewCentral(1), []float64{-1, 3, -3, 1}, 1},
+	{"ThirdCentralO2", stencil.NewCentral(3, 2), third.NewCentral(2), []float64{-1, 2, 0, -2, 1}, 2},
+	{"ThirdCentralO3", stencil.NewCentral(3, 3), third.NewCentral(3), []float64{1, -13, 34, -34, 13, -1}, 8},
+	{"ThirdCentralO4", stencil.NewCentral(3, 4), third.NewCentral(4), []float64{1, -8, 13, 0, -13, 8, -1}, 8},
+	{"FourthForwardO1", stencil.NewForward(4, 1), fourth.NewForward(1), []float64{1, -4, 6, -4, 1}, 1},
+	{"FourthForwardO2", stencil.NewForward(4, 2), fourth.NewForward(2), []float64{3, -14, 26, -24, 11, -2}, 1},
+	{"FourthForwardO3", stencil.NewForward(4, 3), fourth.NewForward(3), []float64{35, -186, 411, -484, 321, -114, 17}, 6},
+	{"FourthForwardO4", stencil.NewForward(4, 4), fourth.NewForward(4), []float64{56, -333, 852, -1219, 1056, -555, 164, -21}, 6},
+	{"FourthBackwardO1", stencil.NewBackward(4, 1), fourth.NewBackward(1), []float64{1, -4, 6, -4, 1}, 1},
+	{"FourthBackwardO2", stencil.NewBackward(4, 2), fourth.NewBackward(2), []float64{-2, 11, -24, 26, -14, 3}, 1},
+	{"FourthBackwardO3", stencil.NewBackward(4, 3), fourth.NewBackward(3), []float64{17, -114, 321, -484, 411, -186, 35}, 6},
+	{"FourthBackwardO4", stencil.NewBackward(4, 4), fourth.NewBackward(4), []float64{-21, 164, -555, 1056, -1219, 852, -333, 56}, 6},
+	{"FourthCentralO1", stencil.NewCentral(4, 1), fourth.NewCentral(1), []float64{1, -4, 6, -4, 1}, 1},
+	{"FourthCentralO2", stencil.NewCentral(4, 2), fourth.NewCentral(2), []float64{1, -3, 2, 2, -3, 1}, 2},
+	{"FourthCentralO3", stencil.NewCentral(4, 3), fourth.NewCentral(3), []float64{-1, 12, -39, 56, -39, 12, -1}, 6},
+	{"FourthCentralO4", stencil.NewCentral(4, 4), fourth.NewCentral(4), []float64{-7, 59, -135, 83, 83, -135, 59, -7}, 48},
+}
+
+func TestHandWritten_coversEveryFormula(t *testing.T) {
+	t.Parallel()
+
+	// Nenhuma fórmula escrita à mão pode ficar de fora das fixtures.
+	derivativeNames := map[uint64]string{1: "First", 2: "Second", 3: "Third", 4: "Fourth"}
+	philosophyNames := map[stencil.Philosophy]string{
+		stencil.Forward:  "Forward",
+		stencil.Backward: "Backward",
+		stencil.Central:  "Central",
+	}
+
+	names := make(map[string]bool, len(handWritten))
+	for _, tc := range handWritten {
+		names[tc.name] = true
+	}
+
+	keys := registry.Keys()
+	for _, key := range keys {
+		name := fmt.Sprintf("%s%sO%d", derivativeNames[key.Derivative], philosophyNames[key.Philosophy], key.ErrorOrder)
+		assert.Truef(t, names[name], "falta a fixture %s (%s)", name, key)
+	}
+	assert.Len(t, handWritten, len(keys))
+}
+
+func TestStencil_reproducesHandWrittenCoefficients(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range handWritten {
+		t.Run(tc.name, func(t *testing.T) {
+			weights := tc.stencil.Weights()
+			require.Len(t, weights, len(tc.coefficients))
+
+			for i, c := range tc.coefficients {
+				assert.Equalf(t, c/tc.divisor, weights[i],
+					"peso %d de %s: esperado %v, obtido %v", i, tc.name, c/tc.divisor, weights[i])
+			}
+		})
+	}
+}
+
+func TestStencil_matchesHandWrittenFormulas(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	f := math.Exp
+	x := 0.7
+	h := 1e-2
+
+	for _, tc := range handWritten {
+		t.Run(tc.name, func(t *testing.T) {
+			// Na quarta derivada o cancelamento cresce com 1/h⁴; com h = 1e-2 as duas somas
+			// já diferem em ~1e-5 relativo.
+			h, epsilon := h, 1e-9
+			if tc.stencil.Derivative() == 4 {
+				h, epsilon = 1e-1, 1e-7
+			}
+
+			expected, err := tc.method.Calculate(ctx, f, x, h)
+			require.NoError(t, err)
+
+			got, err := tc.stencil.Calculate(ctx, f, x, h)
+			require.NoError(t, err)
+
+			assert.InEpsilonf(t, expected, got, epsilon,
+				"Função %s falhou: esperado %.12f, obtido %.12f", tc.name, expected, got)
+		})
+	}
+}
+
+func TestStencil_arbitraryOrders(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	x := 0.3
+
+	tests := []struct {
+		name      string
+		stencil   *stencil.Stencil
+		h         float64
+		tolerance float64
+	}{
+		{"FourthCentralO2", stencil.NewCentral(4, 2), 1e-2, 1e-3},
+		{"FourthForwardO3", stencil.NewForward(4, 3), 1e-2, 1e-3},
+		{"FifthBackwardO2", stencil.NewBackward(5, 2), 2e-2, 1e-1},
+		{"FirstCentralO8", stencil.NewCentral(1, 8), 1e-1, 1e-9},
+		{"SecondHalfStepO4", stencil.NewHalfStep(2, 4), 1e-2, 1e-7},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tc.stencil.Calculate(ctx, math.Exp, x, tc.h)
+			require.NoError(t, err)
+
+			// Todas as derivadas de eˣ são eˣ.
+			assert.InDeltaf(t, math.Exp(x), got, tc.tolerance,
+				"Função %s falhou: esperado %.8f, obtido %.8f", tc.name, math.Exp(x), got)
+		})
+	}
+}
+
+func TestCentralPoints(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		derivative, errorOrder uint64
+		expected               int
+	}{
+		{1, 1, 3},
+		{1, 2, 3},
+		{1, 3, 5},
+		{1, 4, 5},
+		{2, 2, 3},
+		{2, 4, 5},
+		{3, 2, 5},
+		{4, 2, 5},
+		{4, 4, 7},
+	}
+	for _, tc := range tests {
+		n := stencil.CentralPoints(tc.derivative, tc.errorOrder)
+		require.Equalf(t, tc.expected, n, "derivada %d, erro O(h^%d)", tc.derivative, tc.errorOrder)
+
+		// O estêncil de n pontos cai na malha e tem pelo menos a ordem pedida.
+		offsets, err := stencil.Offsets(stencil.Central, n)
+		require.NoError(t, err)
+		s, err := stencil.New(tc.derivative, offsets)
+		require.NoError(t, err)
+		for _, o := range s.Offsets() {
+			assert.Equal(t, math.Trunc(o), o)
+		}
+		assert.GreaterOrEqual(t, s.Describe().ErrorOrder, tc.errorOrder)
+	}
+}
+
+func TestStencil_customOffsets(t *testing.T) {
+	t.Parallel()
+
+	s, err := stencil.New(1, []float64{-1, 0, 2})
+	require.NoError(t, err)
+
+	// Pesos exatos: -2/3, 1/2, 1/6.
+	assert.Equal(t, []float64{-2.0 / 3, 1.0 / 2, 1.0 / 6}, s.Weights())
+
+	// Exata para polinômios de grau 2.
+	got, err := s.Calculate(context.Background(), func(x float64) float64 { return x*x - 3*x }, 1.5, 0.25)
+	require.NoError(t, err)
+	assert.InDelta(t, 0.0, got, 1e-12)
+}
+
+func TestStencil_interpolation(t *testing.T) {
+	t.Parallel()
+
+	// Com derivada 0, New gera os pesos de interpolação.
+	s, err := stencil.New(0, []float64{-1, 1})
+	require.NoError(t, err)
+	assert.Equal(t, []float64{0.5, 0.5}, s.Weights())
+
+	got, err := s.Calculate(context.Background(), func(x float64) float64 { return 3*x + 1 }, 2, 0.5)
+	require.NoError(t, err)
+	assert.InDelta(t, 7.0, got, 1e-12)
+
+	// O passo automático depende da ordem da derivada.
+	_, err = s.Calculate(context.Background(), math.Exp, 2, derivatives.AutoStep)
+	assert.ErrorIs(t, err, derivatives.ErrInvalidStep)
+}
+
+func TestStencil_invalidInput(t *testing.T) {
+	t.Parallel()
+
+	_, err := stencil.New(2, []float64{0, 1})
+	assert.ErrorIs(t, err, stencil.ErrNotEnoughPoints)
+
+	_, err = stencil.New(1, []float64{0, 1, 1})
+	assert.ErrorIs(t, err, stencil.ErrDuplicateOffset)
+
+	_, err = stencil.New(1, []float64{0, math.NaN()})
+	assert.ErrorIs(t, err, stencil.ErrInvalidOffset)
+
+	_, err = stencil.NewPhilosophy(stencil.Forward, 1, 0)
+	assert.Error(t, err)
+
+	_, err = stencil.NewPhilosophy(stencil.Central, 0, 2)
+	assert.Error(t, err)
+
+	assert.Panics(t, func() { stencil.NewCentral(0, 2) })
+}
+
+func TestStencil_estimateEmbedded(t *testing.T) {
+	t.Parallel()
+
+	x := 0.4
+	h := 1e-2
+
+	for _, s := range []*stencil.Stencil{
+		stencil.NewForward(1, 3),
+		stencil.NewCentral(2, 2),
+		stencil.NewBackward(3, 2),
+	} {
+		got, err := s.Estimate(context.Background(), math.Exp, x, h)
+		require.NoError(t, err)
+
+		// A fórmula embutida reaproveita os mesmos valores de f.
+		assert.Equal(t, len(s.Offsets()), got.Evaluations)
+		assert.Len(t, got.Points, len(s.Offsets()))
+		assert.InDelta(t, math.Exp(x), got.Value, got.Bound())
+	}
+
+	// Sem pontos extras não há fórmula embutida.
+	got, err := stencil.NewForward(1, 1).Estimate(context.Background(), math.Exp, x, h)
+	require.NoError(t, err)
+	assert.True(t, math.IsInf(got.ErrorEstimate, 1))
+}
+
+func TestEstimate_againstReference(t *testing.T) {
+	t.Parallel()
+
+	got, err := stencil.Estimate(context.Background(),
+		first.NewCentral(2), stencil.NewCentral(1, 4), math.Sin, 1, 1e-2)
+	require.NoError(t, err)
+
+	// Pontos x±h da fórmula e x±h, x±2h da referência.
+	assert.Len(t, got.Points, 4)
+	assert.Equal(t, 6, got.Evaluations)
+	assert.InDelta(t, math.Cos(1), got.Value, got.Bound())
+	assert.InEpsilon(t, math.Abs(got.Value-math.Cos(1)), got.ErrorEstimate, 1e-2)
+}
+
+func TestWeights_matchesExactStencil(t *testing.T) {
+	t.Parallel()
+
+	for _, s := range []*stencil.Stencil{
+		stencil.NewForward(1, 4),
+		stencil.NewCentral(2, 4),
+		stencil.NewBackward(3, 3),
+	} {
+		got, err := stencil.Weights(s.Derivative(), 0, s.Offsets())
+		require.NoError(t, err)
+		assert.InDeltaSlice(t, s.Weights(), got, 1e-12)
+	}
+
+	// Nós deslocados e com espaçamento h: os pesos absorvem 1/hᵈ.
+	got, err := stencil.Weights(2, 5, []float64{4.5, 5, 5.5})
+	require.NoError(t, err)
+	assert.InDeltaSlice(t, []float64{4, -8, 4}, got, 1e-12)
+
+	_, err = stencil.Weights(2, 0, []float64{0, 1})
+	assert.ErrorIs(t, err, stencil.ErrNotEnoughPoints)
+}
+
+func TestDescribe_handWrittenFormulas(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range handWritten {
+		t.Run(tc.name, func(t *testing.T) {
+			describer, ok := tc.method.(stencil.Describer)
+			require.Truef(t, ok, "%s não expõe o estêncil", tc.name)
+
+			desc := describer.Describe()
+			assert.Equal(t, tc.coefficients, desc.Coefficients)
+			assert.Equal(t, tc.divisor, desc.Divisor)
+			assert.Equal(t, tc.stencil.Offsets(), desc.Offsets)
+			assert.Equal(t, tc.stencil.Derivative(), desc.Derivative)
+			assert.Equal(t, desc.Derivative+desc.ErrorOrder, desc.LeadingDerivative)
+		})
+	}
+}
+
+func TestDescribe_matchesCalculate(t *testing.T) {
+	t.Parallel()
+
+	// As fórmulas escritas à mão não são montadas a partir de Describe; aqui os pesos são
+	// recuperados de Calculate, com h = 1 e f nula exceto em um deslocamento por vez.
+	ctx := context.Background()
+	for _, tc := range handWritten {
+		t.Run(tc.name, func(t *testing.T) {
+			desc := tc.method.(stencil.Describer).Describe()
+			weights := desc.Weights()
+
+			for i, offset := range desc.Offsets {
+				probe := func(x float64) float64 {
+					assert.Containsf(t, desc.Offsets, x, "%s avalia f fora do estêncil descrito", tc.name)
+					if x == offset {
+						return 1
+					}
+					return 0
+				}
+
+				got, err := tc.method.Calculate(ctx, probe, 0, 1)
+				require.NoError(t, err)
+				assert.InDeltaf(t, weights[i], got, 1e-15,
+					"peso do deslocamento %g de %s: esperado %v, obtido %v", offset, tc.name, weights[i], got)
+			}
+		})
+	}
+}
+
+func TestDescribe_leadingTerm(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		stencil     *stencil.Stencil
+		errorOrder  uint64
+		coefficient float64
+	}{
+		// (f(x+h) - f(x))/h = f' + h/2·f'' + ...
+		{"FirstForwardO1", stencil.NewForward(1, 1), 1, 1.0 / 2},
+		// (f(x+h) - f(x-h))/2h = f' + h²/6·f''' + ...
+		{"FirstCentralO2", stencil.NewCentral(1, 2), 2, 1.0 / 6},
+		{"FirstForwardO4", stencil.NewForward(1, 4), 4, -1.0 / 5},
+		{"FirstCentralO4", stencil.NewCentral(1, 4), 4, -1.0 / 30},
+		// (f(x+h) - 2f(x) + f(x-h))/h² = f'' + h²/12·f⁽⁴⁾ + ...
+		{"SecondCentralO1", stencil.NewCentral(2, 1), 2, 1.0 / 12},
+		// A central de 2 pontos em meio-passo: f' + h²/24·f''' + ...
+		{"FirstCentralO1", stencil.NewCentral(1, 1), 2, 1.0 / 24},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			desc := tc.stencil.Describe()
+			assert.Equal(t, tc.errorOrder, desc.ErrorOrder)
+			assert.InDelta(t, tc.coefficient, desc.LeadingCoefficient, 1e-15)
+		})
+	}
+}
+
+func TestDescribe_predictsError(t *testing.T) {
+	t.Parallel()
+
+	// Com h pequeno, o erro de eˣ é dominado por C·hᵖ·eˣ.
+	ctx := context.Background()
+	x := 0.3
+	h := 1e-2
+
+	for _, s := range []*stencil.Stencil{
+		stencil.NewForward(1, 2),
+		stencil.NewBackward(2, 2),
+		stencil.NewCentral(3, 2),
+		stencil.NewForward(4, 1),
+	} {
+		desc := s.Describe()
+		got, err := s.Calculate(ctx, math.Exp, x, h)
+		require.NoError(t, err)
+
+		predicted := desc.LeadingCoefficient * math.Pow(h, float64(desc.ErrorOrder)) * math.Exp(x)
+		assert.InEpsilonf(t, predicted, got-math.Exp(x), 0.1, "%s", desc)
+	}
+}
+
+func TestDescribe_string(t *testing.T) {
+	t.Parallel()
+
+	desc := stencil.NewForward(1, 2).Describe()
+	assert.Equal(t, "f⁽¹⁾(x) ≈ [-3·f(x) + 4·f(x+h) - f(x+2h)] / (2·h^1); erro ≈ -0.3333333333333333·h^2·f⁽³⁾(x)",
+		desc.String())
+	assert.Equal(t, []float64{-1.5, 2, -0.5}, desc.Weights())
+}
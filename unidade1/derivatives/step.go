@@ -0,0 +1,112 @@
+package derivatives
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+)
+
+// AutoStep pode ser passado como h em Calculate para que a fórmula escolha o passo
+// por ResolveStep. O valor é -∞, que não é um passo de verdade: passos nulos e negativos
+// continuam chegando às fórmulas como foram informados.
+var AutoStep = math.Inf(-1)
+
+// ErrInvalidStep indica um passo nulo ou NaN.
+var ErrInvalidStep = errors.New("derivatives: passo inválido")
+
+// autoStepTrials é a quantidade de tentativas de cada lado com que o modo automático
+// refina a estimativa de OptimalStep.
+const autoStepTrials = 2
+
+// epsilon é o épsilon de máquina do float64.
+const epsilon = 0x1p-52
+
+// OptimalStep estima o passo h que equilibra o erro de truncamento O(h^errorOrder) e o
+// erro de cancelamento O(ε/h^derivativeOrder) de uma fórmula de diferenças finitas.
+// xScale é a ordem de grandeza típica de x, e não de f: o passo cresce com
+// L = max(|x|, xScale), e xScale evita um passo minúsculo perto de x = 0.
+//
+// Supondo que f varia na escala L, isto é, |f⁽ᵏ⁾| ≈ |f|/Lᵏ, e minimizando
+// |f|·hᵖ/L^(p+d) + ε·|f|·d/hᵈ, a magnitude de f se cancela:
+//
+//	h* = ((d/p)·ε)^(1/(p+d)) · L
+func OptimalStep(derivativeOrder, errorOrder uint64, x, xScale float64) float64 {
+	if derivativeOrder == 0 || errorOrder == 0 {
+		panic(fmt.Sprintf("ordens inválidas para o passo ótimo: derivada %d, erro %d", derivativeOrder, errorOrder))
+	}
+
+	d := float64(derivativeOrder)
+	p := float64(errorOrder)
+
+	h := math.Pow(d/p*epsilon, 1/(p+d)) * math.Max(math.Abs(x), math.Abs(xScale))
+	if h == 0 {
+		h = math.Pow(d/p*epsilon, 1/(p+d))
+	}
+
+	// Garante que x+h seja representável, para que o passo usado seja exatamente h.
+	return (x + h) - x
+}
+
+// ResolveStep retorna o passo a usar em method: o próprio h ou, se h for AutoStep, a
+// estimativa de OptimalStep refinada por RefineStep. Retorna ErrInvalidStep se h for nulo
+// ou NaN, ou se h for AutoStep e uma das ordens for 0.
+func ResolveStep(ctx context.Context, method DerivativeInterface, f Func, x, h float64, derivativeOrder, errorOrder uint64) (float64, error) {
+	if h == 0 || math.IsNaN(h) {
+		return 0, fmt.Errorf("%w: %g", ErrInvalidStep, h)
+	}
+	if h != AutoStep {
+		return h, nil
+	}
+	if derivativeOrder == 0 || errorOrder == 0 {
+		return 0, fmt.Errorf("%w: passo automático sem ordens válidas: derivada %d, erro %d",
+			ErrInvalidStep, derivativeOrder, errorOrder)
+	}
+
+	return RefineStep(ctx, method, f, x, OptimalStep(derivativeOrder, errorOrder, x, 1), autoStepTrials)
+}
+
+// RefineStep refina o passo inicial h0 avaliando method em h0·2^k, k = -trials..trials,
+// e escolhe o passo em que duas avaliações consecutivas mais concordam, isto é, onde
+// truncamento e cancelamento estão equilibrados.
+func RefineStep(ctx context.Context, method DerivativeInterface, f Func, x, h0 float64, trials int) (float64, error) {
+	if h0 <= 0 {
+		return 0, fmt.Errorf("derivatives: passo inicial inválido: %g", h0)
+	}
+	if trials < 1 {
+		return 0, fmt.Errorf("derivatives: quantidade de tentativas inválida: %d", trials)
+	}
+
+	steps := make([]float64, 2*trials+1)
+	values := make([]float64, len(steps))
+	for i := range steps {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+
+		steps[i] = h0 * math.Pow(2, float64(i-trials))
+		v, err := method.Calculate(ctx, f, x, steps[i])
+		if err != nil {
+			return 0, err
+		}
+		values[i] = v
+	}
+
+	best := h0
+	bestDiff := math.Inf(1)
+	for i := 1; i < len(values); i++ {
+		diff := math.Abs(values[i] - values[i-1])
+		if diff < bestDiff {
+			bestDiff = diff
+			best = steps[i-1]
+		}
+	}
+
+	slog.DebugContext(ctx, "Passo refinado",
+		slog.Float64("h0", h0),
+		slog.Float64("h", best),
+		slog.Float64("diferenca", bestDiff))
+
+	return best, nil
+}
@@ -0,0 +1,107 @@
+// Package tabulated calcula derivadas de dados tabelados (x, y), inclusive em malhas
+// não uniformes, como as amostras vindas de sensores.
+//
+// Em cada amostra usa-se um estêncil central quando há pontos suficientes dos dois
+// lados, progressivo na borda esquerda e regressivo na borda direita. Os pesos são
+// calculados para o espaçamento real das amostras (algoritmo de Fornberg).
+package tabulated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/stencil"
+)
+
+var (
+	// ErrLength indica que xs e ys têm tamanhos diferentes.
+	ErrLength = errors.New("tabulated: xs e ys com tamanhos diferentes")
+	// ErrNotIncreasing indica abscissas fora de ordem ou repetidas.
+	ErrNotIncreasing = errors.New("tabulated: abscissas devem ser estritamente crescentes")
+	// ErrTooFewSamples indica amostras insuficientes para o estêncil pedido.
+	ErrTooFewSamples = errors.New("tabulated: amostras insuficientes")
+)
+
+// First calcula a primeira derivada em todas as amostras com erro O(h^errorOrder).
+func First(ctx context.Context, xs, ys []float64, errorOrder uint64) ([]float64, error) {
+	return Derivative(ctx, xs, ys, 1, errorOrder)
+}
+
+// Second calcula a segunda derivada em todas as amostras com erro O(h^errorOrder).
+func Second(ctx context.Context, xs, ys []float64, errorOrder uint64) ([]float64, error) {
+	return Derivative(ctx, xs, ys, 2, errorOrder)
+}
+
+// Third calcula a terceira derivada em todas as amostras com erro O(h^errorOrder).
+func Third(ctx context.Context, xs, ys []float64, errorOrder uint64) ([]float64, error) {
+	return Derivative(ctx, xs, ys, 3, errorOrder)
+}
+
+// Derivative calcula a derivada de ordem derivative em todas as amostras.
+// Seguindo a convenção dos pacotes first, second e third, as fórmulas unilaterais usam
+// derivative + errorOrder pontos; as centrais usam a menor quantidade ímpar de pontos
+// que não seja menor que essa.
+func Derivative(ctx context.Context, xs, ys []float64, derivative, errorOrder uint64) ([]float64, error) {
+	if len(xs) != len(ys) {
+		return nil, fmt.Errorf("%w: %d e %d", ErrLength, len(xs), len(ys))
+	}
+	for i := 1; i < len(xs); i++ {
+		if !(xs[i] > xs[i-1]) {
+			return nil, fmt.Errorf("%w: xs[%d]=%g, xs[%d]=%g", ErrNotIncreasing, i-1, xs[i-1], i, xs[i])
+		}
+	}
+	if derivative == 0 || errorOrder == 0 {
+		return nil, fmt.Errorf("tabulated: ordens inválidas: derivada %d, erro %d", derivative, errorOrder)
+	}
+
+	n := int(derivative + errorOrder)
+	if len(xs) < n {
+		return nil, fmt.Errorf("%w: %d amostras para um estêncil de %d pontos", ErrTooFewSamples, len(xs), n)
+	}
+
+	// Meia largura do estêncil central.
+	half := n / 2
+
+	slog.DebugContext(ctx, "Calculando a derivada de dados tabelados",
+		slog.Uint64("derivada", derivative),
+		slog.Uint64("ordem", errorOrder),
+		slog.Int("amostras", len(xs)))
+
+	out := make([]float64, len(xs))
+	for i := range xs {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		lo, hi := window(i, len(xs), n, half)
+		weights, err := stencil.Weights(derivative, xs[i], xs[lo:hi])
+		if err != nil {
+			return nil, err
+		}
+
+		var sum float64
+		for k, w := range weights {
+			sum += w * ys[lo+k]
+		}
+		out[i] = sum
+	}
+	return out, nil
+}
+
+// window retorna o intervalo [lo, hi) de amostras usado na amostra i: central se houver
+// half pontos de cada lado, progressivo na borda esquerda e regressivo na direita.
+// Em tabelas curtas a janela unilateral é deslocada para caber nas amostras.
+func window(i, size, n, half int) (int, int) {
+	switch {
+	case i-half >= 0 && i+half < size:
+		return i - half, i + half + 1
+	case i-half < 0:
+		lo := min(i, size-n)
+		return lo, lo + n
+	default:
+		lo := max(i-n+1, 0)
+		return lo, lo + n
+	}
+}
@@ -0,0 +1,115 @@
+package tabulated_test
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/autodiff"
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/tabulated"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// irregularGrid retorna n abscissas em [0, 1] com espaçamento variável.
+func irregularGrid(n int) []float64 {
+	xs := make([]float64, n)
+	for i := range xs {
+		t := float64(i) / float64(n-1)
+		xs[i] = t + 0.03*math.Sin(7*t)
+	}
+	return xs
+}
+
+func sample(xs []float64, f func(float64) float64) []float64 {
+	ys := make([]float64, len(xs))
+	for i, x := range xs {
+		ys[i] = f(x)
+	}
+	return ys
+}
+
+func sinExpTaylor(x autodiff.Taylor) autodiff.Taylor {
+	return x.Scale(3).Sin().Mul(x.Exp())
+}
+
+func sinExp(x float64) float64 {
+	return math.Sin(3*x) * math.Exp(x)
+}
+
+func TestDerivative_irregularGrid(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	xs := irregularGrid(201)
+	ys := sample(xs, sinExp)
+
+	tests := []struct {
+		name       string
+		derivative uint64
+		errorOrder uint64
+		tolerance  float64
+	}{
+		{"FirstO1", 1, 1, 1e-1},
+		{"FirstO2", 1, 2, 1e-3},
+		{"FirstO4", 1, 4, 1e-6},
+		{"SecondO2", 2, 2, 5e-2},
+		{"SecondO4", 2, 4, 1e-4},
+		{"ThirdO2", 3, 2, 1},
+		{"ThirdO4", 3, 4, 1e-2},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tabulated.Derivative(ctx, xs, ys, tc.derivative, tc.errorOrder)
+			require.NoError(t, err)
+			require.Len(t, got, len(xs))
+
+			for i, x := range xs {
+				expected := autodiff.NthDerivative(sinExpTaylor, x, int(tc.derivative))
+				assert.InDeltaf(t, expected, got[i], tc.tolerance,
+					"%s na amostra %d (x=%.4f): esperado %.8f, obtido %.8f", tc.name, i, x, expected, got[i])
+			}
+		})
+	}
+}
+
+func TestDerivative_exactForPolynomials(t *testing.T) {
+	t.Parallel()
+
+	// Com derivada + ordem de erro pontos, polinômios de grau menor são exatos
+	// inclusive nas bordas.
+	xs := []float64{0, 0.1, 0.35, 0.4, 0.7, 0.75, 1.2}
+	ys := sample(xs, func(x float64) float64 { return 2*x*x*x - x + 1 })
+
+	first, err := tabulated.First(context.Background(), xs, ys, 3)
+	require.NoError(t, err)
+	second, err := tabulated.Second(context.Background(), xs, ys, 2)
+	require.NoError(t, err)
+	third, err := tabulated.Third(context.Background(), xs, ys, 1)
+	require.NoError(t, err)
+
+	for i, x := range xs {
+		assert.InDelta(t, 6*x*x-1, first[i], 1e-10)
+		assert.InDelta(t, 12*x, second[i], 1e-9)
+		assert.InDelta(t, 12.0, third[i], 1e-7)
+	}
+}
+
+func TestDerivative_invalidInput(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	_, err := tabulated.First(ctx, []float64{0, 1, 2}, []float64{0, 1}, 1)
+	assert.ErrorIs(t, err, tabulated.ErrLength)
+
+	_, err = tabulated.First(ctx, []float64{0, 2, 1}, []float64{0, 1, 2}, 1)
+	assert.ErrorIs(t, err, tabulated.ErrNotIncreasing)
+
+	_, err = tabulated.Third(ctx, []float64{0, 1, 2}, []float64{0, 1, 2}, 2)
+	assert.ErrorIs(t, err, tabulated.ErrTooFewSamples)
+
+	_, err = tabulated.Derivative(ctx, []float64{0, 1}, []float64{0, 1}, 1, 0)
+	assert.Error(t, err)
+}
@@ -6,14 +6,24 @@ import (
 	"log/slog"
 
 	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives"
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/stencil"
 )
 
-var _ derivatives.DerivativeInterface = (*Backward)(nil)
+var (
+	_ derivatives.EstimatorInterface = (*Backward)(nil)
+	_ stencil.Describer              = (*Backward)(nil)
+)
 
 // Backward é uma struct que calcula a terceira derivada pela filosofia progressiva.
 type Backward struct {
 	// formula armazena a função de cálculo específica (com base na ordem de erro).
 	formula func(ctx context.Context, f derivatives.Func, x, h float64) (float64, error)
+	// errorOrder é a ordem de erro da fórmula, usada no modo de passo automático.
+	errorOrder uint64
+	// reference é a fórmula gerada de ordem de erro maior, usada para estimar o erro.
+	reference *stencil.Stencil
+	// generated é a fórmula gerada com os mesmos coeficientes de formula, usada na introspecção.
+	generated *stencil.Stencil
 }
 
 func NewBackward(errorOrder uint64) *Backward {
@@ -33,16 +43,44 @@ func NewBackward(errorOrder uint64) *Backward {
 	}
 
 	return &Backward{
-		formula: selectedFormula,
+		formula:    selectedFormula,
+		errorOrder: errorOrder,
+		reference:  stencil.NewBackward(3, errorOrder+2),
+		generated:  stencil.NewBackward(3, errorOrder),
 	}
 }
 
 // Calculate executa o cálculo da derivada usando a fórmula que foi definida no NewBackward.
 func (b *Backward) Calculate(ctx context.Context, f derivatives.Func, x, h float64) (float64, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	h, err := derivatives.ResolveStep(ctx, b, f, x, h, 3, b.errorOrder)
+	if err != nil {
+		return 0, err
+	}
+
 	// A mágica acontece aqui: chamamos a fórmula que foi "injetada".
 	return b.formula(ctx, f, x, h)
 }
 
+// Estimate calcula a derivada e estima o erro de truncamento pela diferença para a
+// fórmula gerada com ordem de erro duas unidades maior.
+func (b *Backward) Estimate(ctx context.Context, f derivatives.Func, x, h float64) (derivatives.Result, error) {
+	h, err := derivatives.ResolveStep(ctx, b, f, x, h, 3, b.errorOrder)
+	if err != nil {
+		return derivatives.Result{}, err
+	}
+
+	return stencil.Estimate(ctx, b, b.reference, f, x, h)
+}
+
+// Describe retorna os deslocamentos, coeficientes, divisor e termo dominante do erro da fórmula.
+func (b *Backward) Describe() stencil.Description {
+	return b.generated.Describe()
+}
+
 // backwardOrder1 implementa a fórmula regressiva com erro O(h).
 // backward euler method
 func backwardOrder1(ctx context.Context, f derivatives.Func, x, h float64) (float64, error) {
@@ -67,9 +105,9 @@ func backwardOrder2(ctx context.Context, f derivatives.Func, x, h float64) (floa
 
 	h3 := h * h * h
 
-	numerador := (-f(x-4*h) + 3*f(x-3*h) - 3*f(x-2*h) + f(x-h))
+	numerador := (3*f(x-4*h) - 14*f(x-3*h) + 24*f(x-2*h) - 18*f(x-h) + 5*f(x))
 
-	return numerador / h3, nil
+	return numerador / (2 * h3), nil
 }
 
 func backwardOrder3(ctx context.Context, f derivatives.Func, x, h float64) (float64, error) {
@@ -80,13 +118,13 @@ func backwardOrder3(ctx context.Context, f derivatives.Func, x, h float64) (floa
 
 	h3 := h * h * h
 
-	//   (-13 A + 65 B - 134 C + 142 D - 77 E + 17 F)
-	numerador := (-13*f(x-5*h) +
-		65*f(x-4*h) +
-		-134*f(x-3*h) +
-		142*f(x-2*h) +
-		-77*f(x-h) +
-		+17*f(x))
+	//   (-7 A + 41 B - 98 C + 118 D - 71 E + 17 F)
+	numerador := (-7*f(x-5*h) +
+		41*f(x-4*h) +
+		-98*f(x-3*h) +
+		118*f(x-2*h) +
+		-71*f(x-h) +
+		17*f(x))
 
 	return numerador / (4 * h3), nil
 }
@@ -99,12 +137,12 @@ func backwardOrder4(ctx context.Context, f derivatives.Func, x, h float64) (floa
 
 	h3 := h * h * h
 
-	//  3 A - 56 B + 235 C - 448 D + 449 E - 232 F + 49 G)
-	numerador := (3*f(x-6*h) +
-		-56*f(x-5*h) +
-		235*f(x-4*h) +
-		-448*f(x-3*h) +
-		449*f(x-2*h) +
+	//  (15 A - 104 B + 307 C - 496 D + 461 E - 232 F + 49 G)
+	numerador := (15*f(x-6*h) +
+		-104*f(x-5*h) +
+		307*f(x-4*h) +
+		-496*f(x-3*h) +
+		461*f(x-2*h) +
 		-232*f(x-h) +
 		49*f(x))
 
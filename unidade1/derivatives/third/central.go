@@ -7,14 +7,24 @@ import (
 	"log/slog"
 
 	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives"
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/stencil"
 )
 
-var _ derivatives.DerivativeInterface = (*Central)(nil)
+var (
+	_ derivatives.EstimatorInterface = (*Central)(nil)
+	_ stencil.Describer              = (*Central)(nil)
+)
 
 // Central é uma struct que calcula a terceira derivada pela filosofia progressiva.
 type Central struct {
 	// formula armazena a função de cálculo específica (com base na ordem de erro).
 	formula func(ctx context.Context, f derivatives.Func, x, h float64) (float64, error)
+	// errorOrder é a ordem de erro da fórmula, usada no modo de passo automático.
+	errorOrder uint64
+	// reference é a fórmula gerada de ordem de erro maior, usada para estimar o erro.
+	reference *stencil.Stencil
+	// generated é a fórmula gerada com os mesmos coeficientes de formula, usada na introspecção.
+	generated *stencil.Stencil
 }
 
 func NewCentral(errorOrder uint64) *Central {
@@ -34,16 +44,44 @@ func NewCentral(errorOrder uint64) *Central {
 	}
 
 	return &Central{
-		formula: selectedFormula,
+		formula:    selectedFormula,
+		errorOrder: errorOrder,
+		reference:  stencil.NewCentral(3, errorOrder+2),
+		generated:  stencil.NewCentral(3, errorOrder),
 	}
 }
 
 // Calculate executa o cálculo da derivada usando a fórmula que foi definida no Newcentral.
 func (b *Central) Calculate(ctx context.Context, f derivatives.Func, x, h float64) (float64, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	h, err := derivatives.ResolveStep(ctx, b, f, x, h, 3, b.errorOrder)
+	if err != nil {
+		return 0, err
+	}
+
 	// A mágica acontece aqui: chamamos a fórmula que foi "injetada".
 	return b.formula(ctx, f, x, h)
 }
 
+// Estimate calcula a derivada e estima o erro de truncamento pela diferença para a
+// fórmula gerada com ordem de erro duas unidades maior.
+func (b *Central) Estimate(ctx context.Context, f derivatives.Func, x, h float64) (derivatives.Result, error) {
+	h, err := derivatives.ResolveStep(ctx, b, f, x, h, 3, b.errorOrder)
+	if err != nil {
+		return derivatives.Result{}, err
+	}
+
+	return stencil.Estimate(ctx, b, b.reference, f, x, h)
+}
+
+// Describe retorna os deslocamentos, coeficientes, divisor e termo dominante do erro da fórmula.
+func (b *Central) Describe() stencil.Description {
+	return b.generated.Describe()
+}
+
 func centralOrder1(ctx context.Context, f derivatives.Func, x, h float64) (float64, error) {
 	slog.DebugContext(ctx, "Calculando a derivada progressiva de ordem 1",
 		slog.Uint64("ordem", uint64(1)),
@@ -65,9 +103,9 @@ func centralOrder2(ctx context.Context, f derivatives.Func, x, h float64) (float
 
 	h3 := h * h * h
 
-	numerador := (-2*f(x-2*h) + 7*f(x-h) - 9*f(x) + 5*f(x+h) - f(x+2*h))
+	numerador := (-f(x-2*h) + 2*f(x-h) - 2*f(x+h) + f(x+2*h))
 
-	return numerador / h3, nil
+	return numerador / (2 * h3), nil
 }
 
 func centralOrder3(ctx context.Context, f derivatives.Func, x, h float64) (float64, error) {
@@ -78,12 +116,12 @@ func centralOrder3(ctx context.Context, f derivatives.Func, x, h float64) (float
 
 	h3 := h * h * h
 
-	// -11 A + 35 B - 38 C + 14 D + E - F
-	numerador := (-11*f(x-2.5*h) +
-		35*f(x-1.5*h) +
-		-38*f(x-0.5*h) +
-		14*f(x+0.5*h) +
-		f(x+1.5*h) +
+	// A - 13 B + 34 C - 34 D + 13 E - F
+	numerador := (f(x-2.5*h) +
+		-13*f(x-1.5*h) +
+		34*f(x-0.5*h) +
+		-34*f(x+0.5*h) +
+		13*f(x+1.5*h) +
 		-f(x+2.5*h))
 
 	return numerador / (8 * h3), nil
@@ -96,14 +134,13 @@ func centralOrder4(ctx context.Context, f derivatives.Func, x, h float64) (float
 		slog.Float64("h", h))
 	h3 := h * h * h
 
-	// (-17 A + 70 B - 119 C + 108 D - 55 E + 14 F - G)
+	// (A - 8 B + 13 C + 0 D - 13 E + 8 F - G)
 	// mid is D, the dx to other is mul of h
-	numerador := (-17*f(x-3*h) +
-		70*f(x-2*h) +
-		-119*f(x-h) +
-		108*f(x) +
-		-55*f(x+h) +
-		14*f(x+2*h) +
+	numerador := (f(x-3*h) +
+		-8*f(x-2*h) +
+		13*f(x-h) +
+		-13*f(x+h) +
+		8*f(x+2*h) +
 		-f(x+3*h))
 
 	return numerador / (8 * h3), nil
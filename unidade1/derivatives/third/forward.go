@@ -6,14 +6,24 @@ import (
 	"log/slog"
 
 	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives"
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/stencil"
 )
 
-var _ derivatives.DerivativeInterface = (*Forward)(nil)
+var (
+	_ derivatives.EstimatorInterface = (*Forward)(nil)
+	_ stencil.Describer              = (*Forward)(nil)
+)
 
 // Forward é uma struct que calcula a terceira derivada pela filosofia progressiva.
 type Forward struct {
 	// formula armazena a função de cálculo específica (com base na ordem de erro).
 	formula func(ctx context.Context, f derivatives.Func, x, h float64) (float64, error)
+	// errorOrder é a ordem de erro da fórmula, usada no modo de passo automático.
+	errorOrder uint64
+	// reference é a fórmula gerada de ordem de erro maior, usada para estimar o erro.
+	reference *stencil.Stencil
+	// generated é a fórmula gerada com os mesmos coeficientes de formula, usada na introspecção.
+	generated *stencil.Stencil
 }
 
 func NewForward(errorOrder uint64) *Forward {
@@ -33,16 +43,44 @@ func NewForward(errorOrder uint64) *Forward {
 	}
 
 	return &Forward{
-		formula: selectedFormula,
+		formula:    selectedFormula,
+		errorOrder: errorOrder,
+		reference:  stencil.NewForward(3, errorOrder+2),
+		generated:  stencil.NewForward(3, errorOrder),
 	}
 }
 
 // Calculate executa o cálculo da derivada usando a fórmula que foi definida no Newforward.
 func (b *Forward) Calculate(ctx context.Context, f derivatives.Func, x, h float64) (float64, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	h, err := derivatives.ResolveStep(ctx, b, f, x, h, 3, b.errorOrder)
+	if err != nil {
+		return 0, err
+	}
+
 	// A mágica acontece aqui: chamamos a fórmula que foi "injetada".
 	return b.formula(ctx, f, x, h)
 }
 
+// Estimate calcula a derivada e estima o erro de truncamento pela diferença para a
+// fórmula gerada com ordem de erro duas unidades maior.
+func (b *Forward) Estimate(ctx context.Context, f derivatives.Func, x, h float64) (derivatives.Result, error) {
+	h, err := derivatives.ResolveStep(ctx, b, f, x, h, 3, b.errorOrder)
+	if err != nil {
+		return derivatives.Result{}, err
+	}
+
+	return stencil.Estimate(ctx, b, b.reference, f, x, h)
+}
+
+// Describe retorna os deslocamentos, coeficientes, divisor e termo dominante do erro da fórmula.
+func (b *Forward) Describe() stencil.Description {
+	return b.generated.Describe()
+}
+
 func forwardOrder1(ctx context.Context, f derivatives.Func, x, h float64) (float64, error) {
 	slog.DebugContext(ctx, "Calculando a derivada progressiva de ordem 1",
 		slog.Uint64("ordem", uint64(1)),
@@ -63,9 +101,9 @@ func forwardOrder2(ctx context.Context, f derivatives.Func, x, h float64) (float
 
 	h3 := h * h * h
 
-	numerador := (-4*f(x) + 15*f(x+h) - 21*f(x+2*h) + 13*f(x+3*h) - 3*f(x+4*h))
+	numerador := (-5*f(x) + 18*f(x+h) - 24*f(x+2*h) + 14*f(x+3*h) - 3*f(x+4*h))
 
-	return numerador / h3, nil
+	return numerador / (2 * h3), nil
 }
 
 func forwardOrder3(ctx context.Context, f derivatives.Func, x, h float64) (float64, error) {
@@ -76,12 +114,12 @@ func forwardOrder3(ctx context.Context, f derivatives.Func, x, h float64) (float
 
 	h3 := h * h * h
 
-	//  (-23 A + 95 B - 154 C + 122 D - 47 E + 7 F)
-	numerador := (-23*f(x) +
-		95*f(x+h) +
-		-154*f(x+2*h) +
-		122*f(x+3*h) +
-		-47*f(x+4*h) +
+	//  (-17 A + 71 B - 118 C + 98 D - 41 E + 7 F)
+	numerador := (-17*f(x) +
+		71*f(x+h) +
+		-118*f(x+2*h) +
+		98*f(x+3*h) +
+		-41*f(x+4*h) +
 		7*f(x+5*h))
 
 	return numerador / (4 * h3), nil
@@ -95,12 +133,12 @@ func forwardOrder4(ctx context.Context, f derivatives.Func, x, h float64) (float
 
 	h3 := h * h * h
 
-	//   (-61 A + 280 B - 533 C + 544 D - 319 E + 104 F - 15 G)
-	numerador := (-61*f(x) +
-		280*f(x+h) +
-		-533*f(x+2*h) +
-		544*f(x+3*h) +
-		-319*f(x+4*h) +
+	//   (-49 A + 232 B - 461 C + 496 D - 307 E + 104 F - 15 G)
+	numerador := (-49*f(x) +
+		232*f(x+h) +
+		-461*f(x+2*h) +
+		496*f(x+3*h) +
+		-307*f(x+4*h) +
 		104*f(x+5*h) +
 		-15*f(x+6*h))
 
@@ -2,7 +2,10 @@ module github.com/ArtroxGabriel/numeric-methods-2/unidade1
 
 go 1.24.5
 
-require github.com/stretchr/testify v1.10.0
+require (
+	github.com/stretchr/testify v1.10.0
+	gonum.org/v1/gonum v0.16.0
+)
 
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
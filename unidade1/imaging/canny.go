@@ -0,0 +1,158 @@
+package imaging
+
+import (
+	"context"
+	"image"
+	"image/color"
+	"log/slog"
+	"math"
+)
+
+// DefaultSigma é o desvio padrão padrão da suavização do detector de Canny.
+const DefaultSigma = 1.4
+
+// WithSigma escolhe o desvio padrão da suavização Gaussiana do detector de Canny e dos
+// detectores em cores. O padrão é DefaultSigma.
+func WithSigma(sigma float64) Option {
+	return func(o *options) {
+		o.sigma = sigma
+	}
+}
+
+// WithGradient escolhe os kernels de derivada do detector de Canny e dos detectores em cores.
+// O padrão é SobelOperator.
+func WithGradient(gradient GradientOperator) Option {
+	return func(o *options) {
+		o.gradient = gradient
+	}
+}
+
+// DetectEdgesCanny implementa o detector de Canny, que produz bordas finas e conectadas:
+//
+//  1. suavização Gaussiana com desvio padrão WithSigma;
+//  2. gradiente pelos kernels de WithGradient;
+//  3. supressão dos não máximos na direção do gradiente, que afina as bordas para um pixel;
+//  4. histerese: os pixels com magnitude acima de high são bordas, e os pixels acima de low
+//     só são bordas se estiverem ligados (vizinhança de 8) a uma borda.
+//
+// Os limiares vêm das estratégias low e high, aplicadas à magnitude do gradiente antes da
+// supressão, e são retornados junto com as bordas; se low > high, eles são trocados. Entra em
+// pânico se o desvio padrão não for positivo.
+func DetectEdgesCanny(inputImg *image.Gray, low, high ThresholdStrategy, opts ...Option) (*image.Gray, float64, float64) {
+	o := newOptions(opts)
+
+	// 1) Suavize a imagem
+	blurred := ConvolveFloat(ToFloat(inputImg), GaussianKernel(o.sigma), opts...)
+
+	// 2) Calcule o gradiente
+	gx := ConvolveFloat(blurred, o.gradient.X, opts...)
+	gy := ConvolveFloat(blurred, o.gradient.Y, opts...)
+	magnitude := Magnitude(gx, gy, o.gradient.Scale)
+
+	lowValue := low.Select(magnitude.values())
+	highValue := high.Select(magnitude.values())
+	if lowValue > highValue {
+		lowValue, highValue = highValue, lowValue
+	}
+
+	slog.DebugContext(context.Background(), "Aplicando o detector de Canny",
+		slog.Float64("sigma", o.sigma),
+		slog.String("gradiente", o.gradient.Name),
+		slog.Float64("low", lowValue),
+		slog.Float64("high", highValue))
+
+	// 3) Afine as bordas
+	thin := suppressNonMaxima(magnitude, gx, gy)
+
+	// 4) Ligue as bordas fracas às fortes
+	return hysteresis(thin, lowValue, highValue), lowValue, highValue
+}
+
+// suppressNonMaxima zera os pixels cuja magnitude não é máxima entre os dois vizinhos na
+// direção do gradiente, arredondada para 0°, 45°, 90° ou 135°. Em patamares de dois pixels
+// iguais, só o primeiro na direção do gradiente é mantido.
+func suppressNonMaxima(magnitude, gx, gy *Float) *Float {
+	out := NewFloat(magnitude.Rect)
+	for y := out.Rect.Min.Y; y < out.Rect.Max.Y; y++ {
+		for x := out.Rect.Min.X; x < out.Rect.Max.X; x++ {
+			m := magnitude.At(x, y)
+			if m == 0 {
+				continue
+			}
+
+			dx, dy := gradientDirection(gx.At(x, y), gy.At(x, y))
+			before := magnitude.At(x-dx, y-dy)
+			after := magnitude.At(x+dx, y+dy)
+			if m > before && m >= after {
+				out.Set(x, y, m)
+			}
+		}
+	}
+	return out
+}
+
+// gradientDirection retorna o passo (dx, dy) até o vizinho na direção do gradiente (gx, gy),
+// com o eixo y apontando para baixo, como nas imagens.
+func gradientDirection(gx, gy float64) (int, int) {
+	angle := math.Atan2(gy, gx) * 180 / math.Pi
+	if angle < 0 {
+		angle += 180
+	}
+
+	switch {
+	case angle < 22.5 || angle >= 157.5:
+		return 1, 0
+	case angle < 67.5:
+		return 1, 1
+	case angle < 112.5:
+		return 0, 1
+	default:
+		return -1, 1
+	}
+}
+
+// hysteresis marca como borda (preto) os pixels com magnitude positiva de pelo menos high e os
+// pixels de magnitude positiva de pelo menos low ligados a eles por vizinhança de 8; os demais
+// viram fundo (branco).
+func hysteresis(magnitude *Float, low, high float64) *image.Gray {
+	bounds := magnitude.Rect
+	out := image.NewGray(bounds)
+	for i := range out.Pix {
+		out.Pix[i] = 255 // Fundo (branco)
+	}
+
+	var stack []image.Point
+	mark := func(x, y int) {
+		out.SetGray(x, y, color.Gray{Y: 0}) // Borda (preto)
+		stack = append(stack, image.Pt(x, y))
+	}
+
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			// Os pixels nulos, entre eles os removidos pela supressão, nunca são bordas, mesmo
+			// com high = 0, que os limiares automáticos escolhem em imagens quase constantes.
+			if m := magnitude.At(x, y); m < high || m == 0 || out.GrayAt(x, y).Y == 0 {
+				continue
+			}
+
+			mark(x, y)
+			for len(stack) > 0 {
+				p := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+
+				for ny := p.Y - 1; ny <= p.Y+1; ny++ {
+					for nx := p.X - 1; nx <= p.X+1; nx++ {
+						q := image.Pt(nx, ny)
+						if !q.In(bounds) || out.GrayAt(nx, ny).Y == 0 {
+							continue
+						}
+						if m := magnitude.At(nx, ny); m >= low && m > 0 {
+							mark(nx, ny)
+						}
+					}
+				}
+			}
+		}
+	}
+	return out
+}
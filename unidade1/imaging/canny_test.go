@@ -0,0 +1,142 @@
+package imaging_test
+
+import (
+	"image"
+	"testing"
+
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/imaging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// edgeColumns retorna as colunas marcadas como borda na linha y.
+func edgeColumns(img *image.Gray, y int) []int {
+	var cols []int
+	for x := img.Bounds().Min.X; x < img.Bounds().Max.X; x++ {
+		if img.GrayAt(x, y).Y == 0 {
+			cols = append(cols, x)
+		}
+	}
+	return cols
+}
+
+func TestGaussianKernel(t *testing.T) {
+	k := imaging.GaussianKernel(1)
+	require.Len(t, k, 7)
+
+	var total float64
+	for i := range k {
+		require.Len(t, k[i], 7)
+		for j := range k[i] {
+			total += k[i][j]
+			assert.InDelta(t, k[i][j], k[j][i], 1e-15)
+			assert.InDelta(t, k[i][j], k[6-i][6-j], 1e-15)
+		}
+	}
+	assert.InDelta(t, 1, total, 1e-12)
+	assert.Greater(t, k[3][3], k[3][2])
+
+	assert.Panics(t, func() { imaging.GaussianKernel(0) })
+}
+
+func TestDetectEdgesCanny_Thin(t *testing.T) {
+	// Sobel responde 8 vezes a derivada; os operadores O(h⁴) estão nas unidades da derivada.
+	operators := []struct {
+		op        imaging.GradientOperator
+		low, high imaging.Fixed
+	}{
+		{imaging.SobelOperator, 20, 60},
+		{imaging.CentralO4Operator, 2.5, 7.5},
+	}
+
+	for _, o := range operators {
+		t.Run(o.op.Name, func(t *testing.T) {
+			for _, img := range []*image.Gray{step(24, 12, 12, 50, 200), step(24, 12, 12, 200, 50)} {
+				edges, _, _ := imaging.DetectEdgesCanny(img, o.low, o.high, imaging.WithGradient(o.op))
+
+				// Cada linha tem exatamente um pixel de borda, junto ao degrau.
+				for y := range 12 {
+					cols := edgeColumns(edges, y)
+					require.Len(t, cols, 1, "linha %d", y)
+					assert.InDelta(t, 11.5, float64(cols[0]), 0.5, "linha %d", y)
+				}
+			}
+		})
+	}
+}
+
+func TestDetectEdgesCanny_Hysteresis(t *testing.T) {
+	// Degrau em x=10 cujo contraste cai devagar de 150, no alto, para 32, embaixo, e um degrau
+	// fraco isolado (+20) em x=32.
+	img := image.NewGray(image.Rect(0, 0, 40, 60))
+	for y := range 60 {
+		for x := range 40 {
+			v := 50
+			if x >= 10 {
+				v = 200 - 2*y
+			}
+			if x >= 32 {
+				v += 20
+			}
+			img.Pix[img.PixOffset(x, y)] = uint8(v)
+		}
+	}
+
+	edges, _, _ := imaging.DetectEdgesCanny(img, imaging.Fixed(20), imaging.Fixed(150), imaging.WithBorder(imaging.BorderReplicate))
+
+	// A parte fraca do degrau em x=10 continua a parte forte e é mantida.
+	for y := range 60 {
+		assert.Subset(t, []int{9, 10}, edgeColumns(edges, y), "linha %d", y)
+		assert.NotEmpty(t, edgeColumns(edges, y), "linha %d", y)
+	}
+
+	// Com o limiar alto abaixo da resposta do degrau isolado, ele também vira borda.
+	loose, _, _ := imaging.DetectEdgesCanny(img, imaging.Fixed(20), imaging.Fixed(30), imaging.WithBorder(imaging.BorderReplicate))
+	for _, y := range []int{5, 30, 55} {
+		cols := edgeColumns(loose, y)
+		require.Len(t, cols, 2, "linha %d", y)
+		assert.Contains(t, []int{31, 32}, cols[1], "linha %d", y)
+	}
+}
+
+func TestDetectEdgesCanny_Sigma(t *testing.T) {
+	// Um ruído de um pixel some com sigma grande, mas não com sigma pequeno.
+	img := image.NewGray(image.Rect(0, 0, 21, 21))
+	for i := range img.Pix {
+		img.Pix[i] = 100
+	}
+	img.Pix[img.PixOffset(10, 10)] = 160
+
+	sharp, _, _ := imaging.DetectEdgesCanny(img, imaging.Fixed(10), imaging.Fixed(30), imaging.WithSigma(0.5))
+	smooth, _, _ := imaging.DetectEdgesCanny(img, imaging.Fixed(10), imaging.Fixed(30), imaging.WithSigma(3))
+
+	assert.Contains(t, sharp.Pix, uint8(0))
+	assert.NotContains(t, smooth.Pix, uint8(0))
+}
+
+func TestDetectEdgesCanny_ZeroThreshold(t *testing.T) {
+	// Um quadrado claro de 4×4 num fundo de 64×64: mais de 90% da magnitude é nula, e os
+	// percentis escolhem low = high = 0. Só o contorno do quadrado é borda.
+	img := image.NewGray(image.Rect(0, 0, 64, 64))
+	for y := 30; y < 34; y++ {
+		for x := 30; x < 34; x++ {
+			img.Pix[img.PixOffset(x, y)] = 200
+		}
+	}
+
+	edges, low, high := imaging.DetectEdgesCanny(img, imaging.Percentile(80), imaging.Percentile(90))
+	require.Zero(t, low)
+	require.Zero(t, high)
+
+	var count int
+	for y := range 64 {
+		for x := range 64 {
+			if edges.GrayAt(x, y).Y == 0 {
+				count++
+				assert.True(t, x >= 20 && x < 44 && y >= 20 && y < 44, "borda longe do quadrado em (%d, %d)", x, y)
+			}
+		}
+	}
+	assert.Positive(t, count)
+	assert.Less(t, count, 200)
+}
@@ -0,0 +1,305 @@
+package imaging
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"log/slog"
+	"math"
+)
+
+// ColorSpace define o espaço de cores em que os detectores em cores calculam os gradientes.
+type ColorSpace int
+
+const (
+	// ColorRGB usa os canais R, G e B, com 0–255. É o padrão.
+	ColorRGB ColorSpace = iota
+	// ColorLab usa o espaço CIELAB (iluminante D65), em que a distância entre cores
+	// acompanha a diferença percebida. L*, a* e b* são multiplicados por 2,55, de modo que
+	// L* vai de 0 a 255 como os tons de cinza.
+	ColorLab
+	// ColorHSV usa as coordenadas cartesianas do cone HSV, (V, S·V·cos H, S·V·sin H), com
+	// 0–255. O matiz é circular e indefinido nos cinzas; no cone, a diferença de matiz pesa
+	// pela saturação e pelo brilho, sem o salto de 360° para 0°.
+	ColorHSV
+)
+
+// String retorna o nome do espaço de cores.
+func (c ColorSpace) String() string {
+	switch c {
+	case ColorRGB:
+		return "RGB"
+	case ColorLab:
+		return "Lab"
+	case ColorHSV:
+		return "HSV"
+	default:
+		return fmt.Sprintf("ColorSpace(%d)", int(c))
+	}
+}
+
+// WithColorSpace escolhe o espaço de cores dos detectores em cores. O padrão é ColorRGB.
+func WithColorSpace(space ColorSpace) Option {
+	return func(o *options) {
+		o.colorSpace = space
+	}
+}
+
+// SplitChannels separa a imagem em três canais no espaço de cores space. As cores são as
+// pré-multiplicadas pelo alfa, como em LoadImageGrayscale: os pixels transparentes ficam
+// pretos. Entra em pânico com um espaço de cores desconhecido.
+func SplitChannels(img image.Image, space ColorSpace) []*Float {
+	bounds := img.Bounds()
+	rgba, ok := img.(*image.RGBA)
+	if !ok {
+		rgba = image.NewRGBA(bounds)
+		draw.Draw(rgba, bounds, img, bounds.Min, draw.Src)
+	}
+
+	var convert func(r, g, b uint8) (float64, float64, float64)
+	switch space {
+	case ColorRGB:
+		convert = func(r, g, b uint8) (float64, float64, float64) {
+			return float64(r), float64(g), float64(b)
+		}
+	case ColorLab:
+		convert = rgbToLab
+	case ColorHSV:
+		convert = rgbToHSVCone
+	default:
+		panic(fmt.Sprintf("imaging: espaço de cores desconhecido: %s", space))
+	}
+
+	channels := []*Float{NewFloat(bounds), NewFloat(bounds), NewFloat(bounds)}
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		src := rgba.Pix[rgba.PixOffset(bounds.Min.X, y):]
+		offset := channels[0].PixOffset(bounds.Min.X, y)
+		for x := range bounds.Dx() {
+			c0, c1, c2 := convert(src[4*x], src[4*x+1], src[4*x+2])
+			channels[0].Pix[offset+x] = c0
+			channels[1].Pix[offset+x] = c1
+			channels[2].Pix[offset+x] = c2
+		}
+	}
+	return channels
+}
+
+// srgbLinear[v] é o valor linear, de 0 a 1, do valor sRGB v.
+var srgbLinear = func() [256]float64 {
+	var table [256]float64
+	for v := range table {
+		c := float64(v) / 255
+		if c <= 0.04045 {
+			table[v] = c / 12.92
+		} else {
+			table[v] = math.Pow((c+0.055)/1.055, 2.4)
+		}
+	}
+	return table
+}()
+
+// rgbToLab converte uma cor sRGB para CIELAB (D65), com L*, a* e b* multiplicados por 2,55.
+func rgbToLab(r, g, b uint8) (float64, float64, float64) {
+	lr, lg, lb := srgbLinear[r], srgbLinear[g], srgbLinear[b]
+
+	// XYZ normalizado pelo branco D65.
+	x := (0.4124564*lr + 0.3575761*lg + 0.1804375*lb) / 0.95047
+	y := 0.2126729*lr + 0.7151522*lg + 0.0721750*lb
+	z := (0.0193339*lr + 0.1191920*lg + 0.9503041*lb) / 1.08883
+
+	f := func(t float64) float64 {
+		const delta = 6.0 / 29
+		if t > delta*delta*delta {
+			return math.Cbrt(t)
+		}
+		return t/(3*delta*delta) + 4.0/29
+	}
+	fx, fy, fz := f(x), f(y), f(z)
+
+	const scale = 2.55
+	return (116*fy - 16) * scale, 500 * (fx - fy) * scale, 200 * (fy - fz) * scale
+}
+
+// rgbToHSVCone converte uma cor RGB para as coordenadas cartesianas do cone HSV, com 0–255.
+func rgbToHSVCone(r, g, b uint8) (float64, float64, float64) {
+	rf, gf, bf := float64(r), float64(g), float64(b)
+	hi := max(rf, gf, bf)
+	chroma := hi - min(rf, gf, bf)
+	if chroma == 0 {
+		return hi, 0, 0
+	}
+
+	// Matiz em sextos de volta.
+	var hue float64
+	switch hi {
+	case rf:
+		hue = math.Mod((gf-bf)/chroma+6, 6)
+	case gf:
+		hue = (bf-rf)/chroma + 2
+	default:
+		hue = (rf-gf)/chroma + 4
+	}
+	angle := hue * math.Pi / 3
+
+	// S·V = chroma.
+	return hi, chroma * math.Cos(angle), chroma * math.Sin(angle)
+}
+
+// ColorGradient calcula o gradiente de uma imagem colorida pelo tensor de estrutura de Di
+// Zenzo. Cada canal do espaço de WithColorSpace é suavizado (WithSigma) e derivado
+// (WithGradient); em cada pixel, a matriz
+//
+//	G = [Σ gx²    Σ gx·gy]
+//	    [Σ gx·gy  Σ gy²  ] / canais
+//
+// tem como maior autovalor λ₊ o quadrado da taxa de variação máxima da cor, na direção do
+// autovetor. Retorna a magnitude √λ₊ (vezes a escala do operador) e a orientação desse
+// autovetor, em radianos de 0 a π, medida a partir do eixo x com y para baixo. Como a matriz é
+// a média dos canais, uma imagem em tons de cinza tem a magnitude do gradiente de um canal.
+func ColorGradient(img image.Image, opts ...Option) (magnitude, orientation *Float) {
+	o := newOptions(opts)
+
+	slog.DebugContext(context.Background(), "Calculando o gradiente de Di Zenzo",
+		slog.String("espaço", o.colorSpace.String()),
+		slog.Float64("sigma", o.sigma),
+		slog.String("gradiente", o.gradient.Name))
+
+	channels := SplitChannels(img, o.colorSpace)
+	smoothing := GaussianKernel(o.sigma)
+
+	var gxx, gyy, gxy *Float
+	for _, channel := range channels {
+		blurred := ConvolveFloat(channel, smoothing, opts...)
+		gx := ConvolveFloat(blurred, o.gradient.X, opts...)
+		gy := ConvolveFloat(blurred, o.gradient.Y, opts...)
+		if gxx == nil {
+			gxx, gyy, gxy = NewFloat(gx.Rect), NewFloat(gx.Rect), NewFloat(gx.Rect)
+		}
+		for i := range gx.Pix {
+			gxx.Pix[i] += gx.Pix[i] * gx.Pix[i]
+			gyy.Pix[i] += gy.Pix[i] * gy.Pix[i]
+			gxy.Pix[i] += gx.Pix[i] * gy.Pix[i]
+		}
+	}
+
+	n := float64(len(channels))
+	magnitude, orientation = NewFloat(gxx.Rect), NewFloat(gxx.Rect)
+	for i := range gxx.Pix {
+		a, b, c := gxx.Pix[i]/n, gyy.Pix[i]/n, gxy.Pix[i]/n
+
+		// Autovalores de [[a, c], [c, b]]: (a + b ± √((a - b)² + 4c²))/2.
+		lambda := (a + b + math.Hypot(a-b, 2*c)) / 2
+		magnitude.Pix[i] = math.Sqrt(max(lambda, 0)) * o.gradient.Scale
+
+		theta := math.Atan2(2*c, a-b) / 2
+		if theta < 0 {
+			theta += math.Pi
+		}
+		orientation.Pix[i] = theta
+	}
+	return magnitude, orientation
+}
+
+// DetectEdgesColor detecta bordas numa imagem colorida pela magnitude do gradiente de Di
+// Zenzo (veja ColorGradient), encontrando as bordas entre cores de mesma luminância, que
+// desaparecem na conversão para tons de cinza. O limiar vem da estratégia threshold e é
+// retornado junto com as bordas.
+func DetectEdgesColor(img image.Image, threshold ThresholdStrategy, opts ...Option) (*image.Gray, float64) {
+	magnitude, _ := ColorGradient(img, opts...)
+	return binarize(magnitude, threshold)
+}
+
+// DetectEdgesColorCanny aplica o detector de Canny ao gradiente de Di Zenzo de uma imagem
+// colorida: a supressão dos não máximos segue a orientação do tensor de estrutura, e os
+// limiares da histerese vêm das estratégias low e high, aplicadas à magnitude. Retorna as
+// bordas finas e os limiares escolhidos; se low > high, eles são trocados.
+func DetectEdgesColorCanny(img image.Image, low, high ThresholdStrategy, opts ...Option) (*image.Gray, float64, float64) {
+	magnitude, orientation := ColorGradient(img, opts...)
+	return CannyFromGradient(magnitude, orientation, low, high)
+}
+
+// CannyFromGradient aplica a supressão dos não máximos e a histerese de Canny a um gradiente
+// já calculado, dado pela magnitude e pela orientação em radianos (como as de ColorGradient).
+// Assim o gradiente pode ser reaproveitado, por exemplo em OrientationOverlay, sem ser
+// calculado de novo. Retorna as bordas finas e os limiares escolhidos; se low > high, eles
+// são trocados.
+func CannyFromGradient(magnitude, orientation *Float, low, high ThresholdStrategy) (*image.Gray, float64, float64) {
+	lowValue := low.Select(magnitude.values())
+	highValue := high.Select(magnitude.values())
+	if lowValue > highValue {
+		lowValue, highValue = highValue, lowValue
+	}
+
+	// A direção do gradiente é o autovetor, de sentido indiferente para a supressão.
+	dx, dy := NewFloat(orientation.Rect), NewFloat(orientation.Rect)
+	for i, theta := range orientation.Pix {
+		dx.Pix[i], dy.Pix[i] = math.Cos(theta), math.Sin(theta)
+	}
+
+	thin := suppressNonMaxima(magnitude, dx, dy)
+	return hysteresis(thin, lowValue, highValue), lowValue, highValue
+}
+
+// Overlay desenha as bordas (pixels pretos de edges) na cor c sobre a imagem base, que é
+// convertida para tons de cinza e clareada para destacar as bordas. O resultado tem os
+// limites de edges.
+func Overlay(base image.Image, edges *image.Gray, c color.Color) *image.RGBA {
+	return overlay(base, edges, func(x, y int) color.Color { return c })
+}
+
+// OrientationOverlay desenha as bordas sobre a imagem base com a cor codificando a
+// orientação do gradiente (veja ColorGradient): o matiz dá uma volta completa enquanto a
+// orientação vai de 0 a π, de modo que bordas verticais ficam vermelhas, horizontais, ciano, e
+// as diagonais, verde-amareladas ou roxas.
+func OrientationOverlay(base image.Image, edges *image.Gray, orientation *Float) *image.RGBA {
+	return overlay(base, edges, func(x, y int) color.Color {
+		return hueColor(2 * orientation.At(x, y))
+	})
+}
+
+// overlay pinta os pixels de borda com a cor de paint sobre a base em tons de cinza clareados.
+func overlay(base image.Image, edges *image.Gray, paint func(x, y int) color.Color) *image.RGBA {
+	bounds := edges.Bounds()
+	out := image.NewRGBA(bounds)
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			if edges.GrayAt(x, y).Y == 0 {
+				out.Set(x, y, paint(x, y))
+				continue
+			}
+
+			// Fundo: metade do tom de cinza original, metade branco.
+			v := color.GrayModel.Convert(base.At(x, y)).(color.Gray).Y
+			light := uint8((int(v) + 255) / 2)
+			out.SetRGBA(x, y, color.RGBA{R: light, G: light, B: light, A: 255})
+		}
+	}
+	return out
+}
+
+// hueColor retorna a cor de matiz angle (em radianos), saturação e brilho máximos.
+func hueColor(angle float64) color.RGBA {
+	hue := math.Mod(angle/(math.Pi/3), 6)
+	if hue < 0 {
+		hue += 6
+	}
+	rise := uint8(math.Round(255 * (hue - math.Floor(hue))))
+	fall := 255 - rise
+
+	switch int(hue) {
+	case 0:
+		return color.RGBA{R: 255, G: rise, A: 255}
+	case 1:
+		return color.RGBA{R: fall, G: 255, A: 255}
+	case 2:
+		return color.RGBA{G: 255, B: rise, A: 255}
+	case 3:
+		return color.RGBA{G: fall, B: 255, A: 255}
+	case 4:
+		return color.RGBA{R: rise, B: 255, A: 255}
+	default:
+		return color.RGBA{R: 255, B: fall, A: 255}
+	}
+}
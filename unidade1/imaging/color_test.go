@@ -0,0 +1,170 @@
+package imaging_test
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"testing"
+
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/imaging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// colorStep monta uma imagem width×height com a cor left à esquerda da coluna edge e right
+// a partir dela.
+func colorStep(width, height, edge int, left, right color.RGBA) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := range height {
+		for x := range width {
+			if x < edge {
+				img.SetRGBA(x, y, left)
+			} else {
+				img.SetRGBA(x, y, right)
+			}
+		}
+	}
+	return img
+}
+
+// toGray converte a imagem para tons de cinza como LoadImageGrayscale.
+func toGray(img image.Image) *image.Gray {
+	gray := image.NewGray(img.Bounds())
+	for y := img.Bounds().Min.Y; y < img.Bounds().Max.Y; y++ {
+		for x := img.Bounds().Min.X; x < img.Bounds().Max.X; x++ {
+			gray.Set(x, y, img.At(x, y))
+		}
+	}
+	return gray
+}
+
+var colorSpaces = []imaging.ColorSpace{imaging.ColorRGB, imaging.ColorLab, imaging.ColorHSV}
+
+func TestDetectEdgesColor_EqualLuminance(t *testing.T) {
+	// Vermelho e verde com o mesmo tom de cinza: a borda some na conversão.
+	red, green := color.RGBA{R: 200, A: 255}, color.RGBA{G: 102, A: 255}
+	img := colorStep(24, 12, 12, red, green)
+
+	gray := toGray(img)
+	require.Equal(t, gray.GrayAt(0, 0), gray.GrayAt(23, 0))
+	grayEdges, _ := imaging.DetectEdgesSobel(gray, imaging.Fixed(1))
+	assert.NotContains(t, grayEdges.Pix, uint8(0))
+
+	for _, space := range colorSpaces {
+		t.Run(space.String(), func(t *testing.T) {
+			edges, _ := imaging.DetectEdgesColor(img, imaging.Fixed(1), imaging.WithColorSpace(space))
+			assert.Equal(t, uint8(255), edges.GrayAt(2, 6).Y, "longe da borda não há borda")
+			assert.True(t, edges.GrayAt(11, 6).Y == 0 || edges.GrayAt(12, 6).Y == 0, "a borda deve ser detectada")
+
+			thin, _, _ := imaging.DetectEdgesColorCanny(img, imaging.Fixed(1), imaging.Fixed(5),
+				imaging.WithColorSpace(space))
+			for y := range 12 {
+				cols := edgeColumns(thin, y)
+				require.Len(t, cols, 1, "linha %d", y)
+				assert.InDelta(t, 11.5, float64(cols[0]), 0.5, "linha %d", y)
+			}
+		})
+	}
+}
+
+func TestCannyFromGradient(t *testing.T) {
+	// Reaproveitar o gradiente dá o mesmo resultado de DetectEdgesColorCanny.
+	img := colorStep(24, 12, 12, color.RGBA{R: 200, A: 255}, color.RGBA{G: 102, A: 255})
+	opts := imaging.WithColorSpace(imaging.ColorLab)
+
+	want, wantLow, wantHigh := imaging.DetectEdgesColorCanny(img, imaging.Percentile(80), imaging.Percentile(90), opts)
+
+	magnitude, orientation := imaging.ColorGradient(img, opts)
+	got, low, high := imaging.CannyFromGradient(magnitude, orientation, imaging.Percentile(90), imaging.Percentile(80))
+	assert.Equal(t, want.Pix, got.Pix)
+	assert.Equal(t, wantLow, low, "os limiares são trocados se low > high")
+	assert.Equal(t, wantHigh, high)
+}
+
+func TestColorGradient_Gray(t *testing.T) {
+	// Numa imagem cinza, os três canais RGB são iguais e o tensor médio é o de um canal.
+	gray := noise(20, 16)
+	magnitude, orientation := imaging.ColorGradient(gray, imaging.WithSigma(1))
+
+	blurred := imaging.ConvolveFloat(imaging.ToFloat(gray), imaging.GaussianKernel(1))
+	gx := imaging.ConvolveFloat(blurred, imaging.SobelX)
+	gy := imaging.ConvolveFloat(blurred, imaging.SobelY)
+	want := imaging.Magnitude(gx, gy, 1)
+
+	require.Equal(t, want.Rect, magnitude.Rect)
+	for i := range want.Pix {
+		require.InDelta(t, want.Pix[i], magnitude.Pix[i], 1e-9, "pixel %d", i)
+
+		// A orientação é a do gradiente, a menos do sentido.
+		theta := math.Mod(math.Atan2(gy.Pix[i], gx.Pix[i])+math.Pi, math.Pi)
+		diff := math.Abs(theta - orientation.Pix[i])
+		require.InDelta(t, 0, math.Min(diff, math.Pi-diff), 1e-6, "pixel %d", i)
+	}
+}
+
+func TestColorGradient_Orientation(t *testing.T) {
+	blue, yellow := color.RGBA{B: 200, A: 255}, color.RGBA{R: 200, G: 200, A: 255}
+
+	vertical := colorStep(16, 16, 8, blue, yellow)
+	_, orientation := imaging.ColorGradient(vertical)
+	theta := orientation.At(8, 8)
+	assert.InDelta(t, 0, math.Min(theta, math.Pi-theta), 1e-9, "borda vertical, gradiente em x")
+
+	horizontal := image.NewRGBA(image.Rect(0, 0, 16, 16))
+	for y := range 16 {
+		for x := range 16 {
+			if y < 8 {
+				horizontal.SetRGBA(x, y, blue)
+			} else {
+				horizontal.SetRGBA(x, y, yellow)
+			}
+		}
+	}
+	_, orientation = imaging.ColorGradient(horizontal)
+	assert.InDelta(t, math.Pi/2, orientation.At(8, 8), 1e-9, "borda horizontal, gradiente em y")
+}
+
+func TestSplitChannels(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 4, 1))
+	img.SetRGBA(0, 0, color.RGBA{R: 255, G: 255, B: 255, A: 255})
+	img.SetRGBA(1, 0, color.RGBA{R: 255, A: 255})
+	img.SetRGBA(2, 0, color.RGBA{G: 200, A: 255})
+	// (3, 0) é transparente.
+
+	at := func(channels []*imaging.Float, x int) []float64 {
+		return []float64{channels[0].At(x, 0), channels[1].At(x, 0), channels[2].At(x, 0)}
+	}
+
+	rgb := imaging.SplitChannels(img, imaging.ColorRGB)
+	assert.Equal(t, []float64{255, 0, 0}, at(rgb, 1))
+	assert.Equal(t, []float64{0, 0, 0}, at(rgb, 3))
+
+	lab := imaging.SplitChannels(img, imaging.ColorLab)
+	assert.InDeltaSlice(t, []float64{255, 0, 0}, at(lab, 0), 1e-2, "branco")
+	assert.InDeltaSlice(t, []float64{0, 0, 0}, at(lab, 3), 1e-9, "preto")
+	assert.InDeltaSlice(t, []float64{53.24 * 2.55, 80.09 * 2.55, 67.20 * 2.55}, at(lab, 1), 0.1, "vermelho")
+
+	hsv := imaging.SplitChannels(img, imaging.ColorHSV)
+	assert.InDeltaSlice(t, []float64{255, 0, 0}, at(hsv, 0), 1e-12, "branco")
+	assert.InDeltaSlice(t, []float64{255, 255, 0}, at(hsv, 1), 1e-12, "vermelho")
+	assert.InDeltaSlice(t, []float64{200, -100, 100 * math.Sqrt(3)}, at(hsv, 2), 1e-9, "verde, matiz 120°")
+
+	assert.Panics(t, func() { imaging.SplitChannels(img, imaging.ColorSpace(42)) })
+}
+
+func TestOverlay(t *testing.T) {
+	base := image.NewGray(image.Rect(0, 0, 2, 2))
+	base.Pix = []uint8{100, 100, 100, 100}
+	edges := image.NewGray(image.Rect(0, 0, 2, 2))
+	edges.Pix = []uint8{0, 255, 255, 0}
+
+	out := imaging.Overlay(base, edges, color.RGBA{R: 255, A: 255})
+	assert.Equal(t, color.RGBA{R: 255, A: 255}, out.RGBAAt(0, 0))
+	assert.Equal(t, color.RGBA{R: 177, G: 177, B: 177, A: 255}, out.RGBAAt(1, 0), "fundo clareado")
+
+	orientation := imaging.NewFloat(edges.Rect)
+	orientation.Set(1, 1, math.Pi/2)
+	out = imaging.OrientationOverlay(base, edges, orientation)
+	assert.Equal(t, color.RGBA{R: 255, A: 255}, out.RGBAAt(0, 0), "borda vertical")
+	assert.Equal(t, color.RGBA{G: 255, B: 255, A: 255}, out.RGBAAt(1, 1), "borda horizontal")
+}
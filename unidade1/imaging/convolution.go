@@ -1,38 +1,379 @@
 package imaging
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"image"
-	"image/color"
-	"math"
+	"runtime"
+	"sync"
 )
 
-// Convolve aplica um kernel a uma imagem em tons de cinza.
-func Convolve(img *image.Gray, kernel [][]float64) *image.Gray {
+// ErrInvalidOption indica uma opção de convolução inválida.
+var ErrInvalidOption = errors.New("imaging: opção inválida")
+
+// DefaultTileSize é o lado padrão, em pixels, dos blocos da convolução paralela.
+const DefaultTileSize = 128
+
+// Border define como Convolve trata os vizinhos que caem fora da imagem.
+type Border int
+
+const (
+	// BorderReflect espelha a imagem sem repetir o pixel da borda (dcb|abcd|cba). É o padrão,
+	// pois preserva as derivadas perto da borda sem criar bordas falsas no contorno da imagem.
+	BorderReflect Border = iota
+	// BorderZero completa a imagem com pixels pretos (000|abcd|000).
+	BorderZero
+	// BorderReplicate repete o pixel da borda (aaa|abcd|ddd).
+	BorderReplicate
+	// BorderWrap trata a imagem como periódica (bcd|abcd|abc).
+	BorderWrap
+	// BorderCrop descarta os pixels cujo kernel sairia da imagem: a saída tem os limites da
+	// entrada encolhidos pelo raio do kernel, mantendo as coordenadas dos pixels restantes.
+	BorderCrop
+)
+
+// String retorna o nome do modo de borda.
+func (b Border) String() string {
+	switch b {
+	case BorderReflect:
+		return "reflexão"
+	case BorderZero:
+		return "zeros"
+	case BorderReplicate:
+		return "replicação"
+	case BorderWrap:
+		return "periódica"
+	case BorderCrop:
+		return "recorte"
+	default:
+		return fmt.Sprintf("Border(%d)", int(b))
+	}
+}
+
+// options reúne as opções da convolução e dos detectores de bordas.
+type options struct {
+	// border é o tratamento dos vizinhos fora da imagem.
+	border Border
+	// sigma é o desvio padrão da suavização Gaussiana do detector de Canny e dos detectores
+	// em cores.
+	sigma float64
+	// gradient são os kernels de derivada do detector de Canny e dos detectores em cores.
+	gradient GradientOperator
+	// filter é o cálculo do Laplaciano da Gaussiana em DetectEdgesLoG.
+	filter LaplacianFilter
+	// scales são os desvios padrão de DetectEdgesLoG; se vazio, usa sigma.
+	scales []float64
+	// combination combina as escalas de DetectEdgesLoG.
+	combination ScaleCombination
+	// workers é o número máximo de blocos convoluídos simultaneamente.
+	workers int
+	// tileSize é o lado dos blocos da convolução.
+	tileSize int
+	// strategy é o algoritmo da convolução.
+	strategy Strategy
+	// colorSpace é o espaço de cores dos detectores em cores.
+	colorSpace ColorSpace
+}
+
+// Option configura Convolve e os detectores de bordas.
+type Option func(*options)
+
+// WithBorder escolhe o tratamento dos vizinhos fora da imagem. O padrão é BorderReflect.
+func WithBorder(border Border) Option {
+	return func(o *options) {
+		o.border = border
+	}
+}
+
+// WithWorkers limita a quantidade de blocos convoluídos simultaneamente. O padrão é
+// runtime.GOMAXPROCS(0); WithWorkers(1) usa o caminho serial.
+func WithWorkers(workers int) Option {
+	return func(o *options) {
+		o.workers = workers
+	}
+}
+
+// WithTileSize escolhe o lado, em pixels, dos blocos da convolução. O padrão é DefaultTileSize.
+func WithTileSize(size int) Option {
+	return func(o *options) {
+		o.tileSize = size
+	}
+}
+
+// newOptions aplica opts sobre os valores padrão.
+func newOptions(opts []Option) options {
+	o := options{
+		border:   BorderReflect,
+		sigma:    DefaultSigma,
+		gradient: SobelOperator,
+		workers:  runtime.GOMAXPROCS(0),
+		tileSize: DefaultTileSize,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// Convolve aplica um kernel a uma imagem em tons de cinza, limitando o resultado a 0–255.
+// Para manter respostas negativas ou acima de 255, use ConvolveFloat.
+func Convolve(img *image.Gray, kernel [][]float64, opts ...Option) *image.Gray {
+	return ConvolveFloat(ToFloat(img), kernel, opts...).Gray()
+}
+
+// ConvolveFloat aplica um kernel a uma imagem de ponto flutuante, sem limitar o resultado.
+// O kernel pode ser retangular e é centrado no elemento kernel[len(kernel)/2][len(kernel[0])/2].
+// Os vizinhos fora da imagem são tratados conforme WithBorder, e o trabalho é dividido em
+// blocos conforme WithWorkers e WithTileSize. Entra em pânico se essas opções forem inválidas.
+func ConvolveFloat(img *Float, kernel [][]float64, opts ...Option) *Float {
+	out, err := ConvolveFloatContext(context.Background(), img, kernel, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return out
+}
+
+// ConvolveFloatContext é ConvolveFloat com cancelamento. A estratégia vem de WithStrategy; no
+// padrão, StrategyAuto, kernels separáveis são aplicados em duas passadas 1D e kernels grandes
+// não separáveis, pela FFT. O trabalho é dividido entre WithWorkers goroutines, e cada pixel
+// soma os mesmos termos na mesma ordem do caminho serial (WithWorkers(1)), de modo que, para
+// uma mesma estratégia, o resultado é idêntico bit a bit para qualquer quantidade de workers.
+//
+// Ao cancelar ctx, nenhum novo bloco é iniciado e ConvolveFloatContext retorna ctx.Err()
+// assim que os blocos em andamento terminam.
+func ConvolveFloatContext(ctx context.Context, img *Float, kernel [][]float64, opts ...Option) (*Float, error) {
+	o := newOptions(opts)
+	if o.workers < 1 {
+		return nil, fmt.Errorf("%w: %d workers", ErrInvalidOption, o.workers)
+	}
+	if o.tileSize < 1 {
+		return nil, fmt.Errorf("%w: blocos de %d pixels", ErrInvalidOption, o.tileSize)
+	}
+	if len(kernel) == 0 || len(kernel[0]) == 0 {
+		return nil, fmt.Errorf("%w: kernel vazio", ErrInvalidOption)
+	}
+	for _, row := range kernel {
+		if len(row) != len(kernel[0]) {
+			return nil, fmt.Errorf("%w: kernel com linhas de tamanhos diferentes", ErrInvalidOption)
+		}
+	}
+
+	strategy := o.strategy
+	if strategy == StrategyAuto {
+		strategy = chooseStrategy(img.Rect, kernel)
+	}
+
+	switch strategy {
+	case StrategyDirect:
+		return convolveDirect(ctx, img, kernel, o)
+	case StrategySeparable:
+		column, row, ok := Separate(kernel)
+		if !ok {
+			return nil, fmt.Errorf("%w: kernel %dx%d não separável", ErrInvalidOption, len(kernel[0]), len(kernel))
+		}
+		return convolveSeparable(ctx, img, column, row, opts)
+	case StrategyFFT:
+		return convolveFFT(ctx, img, kernel, o)
+	default:
+		return nil, fmt.Errorf("%w: estratégia %s", ErrInvalidOption, strategy)
+	}
+}
+
+// outputBounds retorna os limites da saída da convolução de uma imagem com limites bounds
+// por um kernel kernelWidth×kernelHeight: os mesmos da entrada ou, com BorderCrop, recortados.
+func outputBounds(bounds image.Rectangle, kernelWidth, kernelHeight int, border Border) image.Rectangle {
+	if border != BorderCrop {
+		return bounds
+	}
+
+	// image.Rect reordenaria os cantos de um recorte vazio.
+	radiusY, radiusX := kernelHeight/2, kernelWidth/2
+	r := image.Rectangle{
+		Min: image.Pt(bounds.Min.X+radiusX, bounds.Min.Y+radiusY),
+		Max: image.Pt(bounds.Max.X-(kernelWidth-1-radiusX), bounds.Max.Y-(kernelHeight-1-radiusY)),
+	}
+	if r.Empty() {
+		return image.Rectangle{}
+	}
+	return r
+}
+
+// convolveDirect calcula a convolução pela definição, dividindo a saída em blocos de
+// o.tileSize pixels de lado.
+func convolveDirect(ctx context.Context, img *Float, kernel [][]float64, o options) (*Float, error) {
 	bounds := img.Bounds()
 	width, height := bounds.Dx(), bounds.Dy()
 
-	// Cria uma imagem de saída com o mesmo tamanho
-	out := image.NewGray(bounds)
+	kernelHeight, kernelWidth := len(kernel), len(kernel[0])
+	radiusY, radiusX := kernelHeight/2, kernelWidth/2
+
+	// Cria uma imagem de saída com o mesmo tamanho (ou recortada)
+	outBounds := outputBounds(bounds, kernelWidth, kernelHeight, o.border)
+	out := NewFloat(outBounds)
+
+	c := convolution{
+		img:    img,
+		out:    out,
+		kernel: kernel,
+		// rows[y-bounds.Min.Y+ky] e cols[x-bounds.Min.X+kx] dão a linha e a coluna da
+		// imagem lidas pelo elemento (kx, ky) do kernel no pixel (x, y), já resolvidas pelo
+		// modo de borda; -1 indica um zero.
+		rows: borderIndex(height, radiusY, kernelHeight-1-radiusY, o.border),
+		cols: borderIndex(width, radiusX, kernelWidth-1-radiusX, o.border),
+	}
+
+	var tiles []image.Rectangle
+	for y := outBounds.Min.Y; y < outBounds.Max.Y; y += o.tileSize {
+		for x := outBounds.Min.X; x < outBounds.Max.X; x += o.tileSize {
+			tiles = append(tiles, image.Rect(x, y, x+o.tileSize, y+o.tileSize).Intersect(outBounds))
+		}
+	}
+
+	err := forEach(ctx, o.workers, len(tiles), func(_, i int) {
+		c.tile(tiles[i])
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// forEach chama fn(worker, i) para i = 0, ..., n-1 em até workers goroutines; worker
+// identifica a goroutine, em [0, workers), para que fn reaproveite memória própria. Ao cancelar
+// ctx, nenhum novo índice é iniciado e forEach retorna ctx.Err() quando os em andamento terminam.
+func forEach(ctx context.Context, workers, n int, fn func(worker, i int)) error {
+	workers = min(workers, n)
+	if workers <= 1 {
+		for i := range n {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			fn(0, i)
+		}
+		return ctx.Err()
+	}
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				fn(w, i)
+			}
+		}()
+	}
+
+feed:
+	for i := range n {
+		select {
+		case jobs <- i:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	return ctx.Err()
+}
 
-	kernelSize := len(kernel)
-	kernelRadius := kernelSize / 2
+// convolution reúne os dados compartilhados pelos blocos de uma convolução.
+type convolution struct {
+	// img e out são as imagens de entrada e de saída.
+	img, out *Float
+	// kernel é o kernel aplicado.
+	kernel [][]float64
+	// rows e cols mapeiam as posições lidas para as linhas e colunas de img.
+	rows, cols []int
+}
 
-	// Itera sobre cada pixel da imagem (ignorando as bordas para simplificar)
-	for y := kernelRadius; y < height-kernelRadius; y++ {
-		for x := kernelRadius; x < width-kernelRadius; x++ {
+// tile calcula os pixels de saída no retângulo r. Blocos distintos escrevem em posições
+// distintas de out.Pix e podem ser calculados em paralelo.
+func (c *convolution) tile(r image.Rectangle) {
+	bounds := c.img.Rect
+	kernelWidth := len(c.kernel[0])
+	radiusX := kernelWidth / 2
+
+	// Nas colunas [inLo, inHi) o kernel cabe na imagem e lê pixels contíguos de cada linha.
+	inLo := max(r.Min.X, bounds.Min.X+radiusX)
+	inHi := max(inLo, min(r.Max.X, bounds.Max.X-(kernelWidth-1-radiusX)))
+
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		dst := c.out.Pix[c.out.PixOffset(r.Min.X, y):]
+		for x := r.Min.X; x < r.Max.X; x++ {
 			var sum float64 = 0
 			// Aplica o kernel
-			for ky := range kernelSize {
-				for kx := range kernelSize {
-					// Pega o valor do pixel da imagem original
-					pixelValue := float64(img.GrayAt(x-kernelRadius+kx, y-kernelRadius+ky).Y)
-					// Multiplica pelo valor do kernel
-					sum += pixelValue * kernel[ky][kx]
+			for ky, weights := range c.kernel {
+				row := c.rows[y-bounds.Min.Y+ky]
+				if row < 0 {
+					continue
+				}
+				line := c.img.Pix[row*c.img.Stride:]
+
+				if x >= inLo && x < inHi {
+					src := line[x-bounds.Min.X-radiusX:][:len(weights)]
+					for kx, w := range weights {
+						sum += src[kx] * w
+					}
+					continue
+				}
+
+				cols := c.cols[x-bounds.Min.X:]
+				for kx, w := range weights {
+					col := cols[kx]
+					if col < 0 {
+						continue
+					}
+					// Multiplica o valor do pixel original pelo valor do kernel
+					sum += line[col] * w
 				}
 			}
-			// Normaliza o valor para o range de 0-255 e atribui ao pixel de saída
-			out.SetGray(x, y, color.Gray{Y: uint8(math.Max(0, math.Min(255, sum)))})
+			dst[x-r.Min.X] = sum
 		}
 	}
-	return out
+}
+
+// borderIndex mapeia as posições -before, ..., n-1+after de uma linha ou coluna de n pixels
+// para os índices lidos segundo o modo de borda. O resultado i corresponde à posição i-before;
+// -1 indica um pixel nulo (BorderZero).
+func borderIndex(n, before, after int, border Border) []int {
+	index := make([]int, before+n+after)
+	for i := range index {
+		p := i - before
+		switch {
+		case p >= 0 && p < n:
+			index[i] = p
+		case n == 0:
+			index[i] = -1
+		case border == BorderZero:
+			index[i] = -1
+		case border == BorderReplicate:
+			index[i] = min(max(p, 0), n-1)
+		case border == BorderWrap:
+			index[i] = ((p % n) + n) % n
+		case border == BorderReflect:
+			index[i] = reflect(p, n)
+		default:
+			// BorderCrop nunca lê fora da imagem.
+			index[i] = min(max(p, 0), n-1)
+		}
+	}
+	return index
+}
+
+// reflect espelha p em [0, n) sem repetir os extremos, repetindo a reflexão quando o kernel
+// é maior que a imagem.
+func reflect(p, n int) int {
+	if n == 1 {
+		return 0
+	}
+	period := 2 * (n - 1)
+	p = ((p % period) + period) % period
+	if p >= n {
+		p = period - p
+	}
+	return p
 }
@@ -0,0 +1,297 @@
+package imaging_test
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/imaging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// row monta uma imagem de uma linha com os valores dados.
+func row(values ...uint8) *image.Gray {
+	img := image.NewGray(image.Rect(0, 0, len(values), 1))
+	copy(img.Pix, values)
+	return img
+}
+
+func TestConvolve_Border(t *testing.T) {
+	// O kernel lê os vizinhos a dois pixels de distância: a saída em x é p(x-2) + p(x+2)/2.
+	kernel := [][]float64{{1, 0, 0, 0, 0.5}}
+	img := row(10, 20, 30, 40)
+
+	tests := []struct {
+		name   string
+		border imaging.Border
+		want   []uint8
+	}{
+		// Posições lidas: x=0 → (-2, 2); x=1 → (-1, 3); x=2 → (0, 4); x=3 → (1, 5).
+		{"zeros", imaging.BorderZero, []uint8{15, 20, 10, 20}},
+		{"replicação", imaging.BorderReplicate, []uint8{25, 30, 30, 40}},
+		{"reflexão", imaging.BorderReflect, []uint8{45, 40, 25, 30}},
+		{"periódica", imaging.BorderWrap, []uint8{45, 60, 15, 30}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := imaging.Convolve(img, kernel, imaging.WithBorder(tt.border))
+
+			assert.Equal(t, img.Bounds(), got.Bounds())
+			assert.Equal(t, tt.want, got.Pix)
+		})
+	}
+}
+
+func TestConvolve_Crop(t *testing.T) {
+	img := image.NewGray(image.Rect(0, 0, 8, 7))
+	for i := range img.Pix {
+		img.Pix[i] = uint8(7 * i)
+	}
+
+	full := imaging.Convolve(img, imaging.GaussianKernel5x5, imaging.WithBorder(imaging.BorderZero))
+	cropped := imaging.Convolve(img, imaging.GaussianKernel5x5, imaging.WithBorder(imaging.BorderCrop))
+
+	// O recorte mantém as coordenadas: só os pixels cujo kernel cabe na imagem sobram.
+	require.Equal(t, image.Rect(2, 2, 6, 5), cropped.Bounds())
+	for y := 2; y < 5; y++ {
+		for x := 2; x < 6; x++ {
+			assert.Equal(t, full.GrayAt(x, y), cropped.GrayAt(x, y), "(%d, %d)", x, y)
+		}
+	}
+
+	// Recortes encadeados continuam a partir dos limites já recortados.
+	twice := imaging.Convolve(cropped, imaging.SobelX, imaging.WithBorder(imaging.BorderCrop))
+	assert.Equal(t, image.Rect(3, 3, 5, 4), twice.Bounds())
+
+	tiny := imaging.Convolve(row(1, 2, 3), imaging.GaussianKernel5x5, imaging.WithBorder(imaging.BorderCrop))
+	assert.True(t, tiny.Bounds().Empty())
+}
+
+func TestConvolve_ReflectLargeKernel(t *testing.T) {
+	// Com a imagem menor que o raio, a reflexão se repete: -3 → 1, 3 → 1.
+	kernel := [][]float64{{1, 0, 0, 0, 0, 0, 1}}
+	got := imaging.Convolve(row(10, 20), kernel, imaging.WithBorder(imaging.BorderReflect))
+
+	assert.Equal(t, []uint8{40, 20}, got.Pix)
+}
+
+func TestDetectEdges_Border(t *testing.T) {
+	// Uma imagem uniforme não tem bordas; sem tratamento de borda, o contorno aparecia como borda.
+	img := image.NewGray(image.Rect(0, 0, 16, 12))
+	for i := range img.Pix {
+		img.Pix[i] = 128
+	}
+
+	detectors := []struct {
+		name      string
+		detect    func(*image.Gray, imaging.ThresholdStrategy, ...imaging.Option) (*image.Gray, float64)
+		threshold imaging.ThresholdStrategy
+	}{
+		{"Sobel", imaging.DetectEdgesSobel, imaging.Fixed(100)},
+		{"Laplaciano", imaging.DetectEdgesLaplacian, imaging.Fixed(5)},
+		{"Central O(h⁴)", imaging.DetectEdgesCentralO4, imaging.Fixed(15)},
+		{"Backward O(h⁴)", imaging.DetectEdgesBackward04, imaging.Fixed(15)},
+		{"Forward O(h⁴)", imaging.DetectEdgesForward04, imaging.Fixed(15)},
+	}
+
+	for _, d := range detectors {
+		t.Run(d.name, func(t *testing.T) {
+			for _, border := range []imaging.Border{imaging.BorderReflect, imaging.BorderReplicate, imaging.BorderWrap} {
+				edges, _ := d.detect(img, d.threshold, imaging.WithBorder(border))
+				require.Equal(t, img.Bounds(), edges.Bounds(), border.String())
+				for _, v := range edges.Pix {
+					require.Equal(t, uint8(255), v, border.String())
+				}
+			}
+
+			cropped, _ := d.detect(img, d.threshold, imaging.WithBorder(imaging.BorderCrop))
+			assert.Less(t, cropped.Bounds().Dx(), img.Bounds().Dx())
+			assert.True(t, cropped.Bounds().In(img.Bounds()))
+		})
+	}
+}
+
+// noise monta uma imagem pseudoaleatória reproduzível.
+func noise(width, height int) *image.Gray {
+	rng := rand.New(rand.NewPCG(1, 2))
+	img := image.NewGray(image.Rect(0, 0, width, height))
+	for i := range img.Pix {
+		img.Pix[i] = uint8(rng.IntN(256))
+	}
+	return img
+}
+
+func TestConvolveFloat_Parallel(t *testing.T) {
+	img := imaging.ToFloat(noise(131, 97))
+	kernels := map[string][][]float64{
+		"Gaussiano 5x5": imaging.GaussianKernel5x5,
+		"Gaussiano σ=2": imaging.GaussianKernel(2),
+		"Central O(h⁴)": imaging.CentralO4X,
+		"1x7":           {{0.1, -0.3, 0.7, 1.1, -0.2, 0.05, 0.3}},
+	}
+	borders := []imaging.Border{
+		imaging.BorderReflect, imaging.BorderZero, imaging.BorderReplicate,
+		imaging.BorderWrap, imaging.BorderCrop,
+	}
+
+	for name, kernel := range kernels {
+		for _, border := range borders {
+			serial := imaging.ConvolveFloat(img, kernel,
+				imaging.WithBorder(border), imaging.WithWorkers(1))
+
+			for _, cfg := range []struct{ workers, tile int }{{2, 3}, {4, 16}, {8, 37}, {3, 1000}} {
+				t.Run(fmt.Sprintf("%s/%s/%d workers/bloco %d", name, border, cfg.workers, cfg.tile), func(t *testing.T) {
+					got, err := imaging.ConvolveFloatContext(context.Background(), img, kernel,
+						imaging.WithBorder(border),
+						imaging.WithWorkers(cfg.workers),
+						imaging.WithTileSize(cfg.tile))
+					require.NoError(t, err)
+
+					// Idêntico bit a bit ao caminho serial.
+					require.Equal(t, serial.Rect, got.Rect)
+					for i := range serial.Pix {
+						require.Equal(t, math.Float64bits(serial.Pix[i]), math.Float64bits(got.Pix[i]), "pixel %d", i)
+					}
+				})
+			}
+		}
+	}
+}
+
+func TestConvolveFloatContext_Cancel(t *testing.T) {
+	img := imaging.ToFloat(noise(256, 256))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	for _, workers := range []int{1, 4} {
+		out, err := imaging.ConvolveFloatContext(ctx, img, imaging.GaussianKernel5x5,
+			imaging.WithWorkers(workers), imaging.WithTileSize(16))
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Nil(t, out)
+	}
+}
+
+func TestConvolveFloatContext_InvalidOptions(t *testing.T) {
+	img := imaging.ToFloat(noise(8, 8))
+	ctx := context.Background()
+
+	_, err := imaging.ConvolveFloatContext(ctx, img, imaging.SobelX, imaging.WithWorkers(0))
+	assert.ErrorIs(t, err, imaging.ErrInvalidOption)
+
+	_, err = imaging.ConvolveFloatContext(ctx, img, imaging.SobelX, imaging.WithTileSize(0))
+	assert.ErrorIs(t, err, imaging.ErrInvalidOption)
+
+	_, err = imaging.ConvolveFloatContext(ctx, img, nil)
+	assert.ErrorIs(t, err, imaging.ErrInvalidOption)
+
+	assert.Panics(t, func() { imaging.ConvolveFloat(img, imaging.SobelX, imaging.WithWorkers(-1)) })
+}
+
+// convolveGrayAt é a convolução original, pixel a pixel por GrayAt e SetGray e sem tratar as
+// bordas, mantida como referência para os benchmarks.
+func convolveGrayAt(img *image.Gray, kernel [][]float64) *image.Gray {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	out := image.NewGray(bounds)
+
+	kernelSize := len(kernel)
+	kernelRadius := kernelSize / 2
+	for y := kernelRadius; y < height-kernelRadius; y++ {
+		for x := kernelRadius; x < width-kernelRadius; x++ {
+			var sum float64
+			for ky := range kernelSize {
+				for kx := range kernelSize {
+					pixelValue := float64(img.GrayAt(x-kernelRadius+kx, y-kernelRadius+ky).Y)
+					sum += pixelValue * kernel[ky][kx]
+				}
+			}
+			out.SetGray(x, y, color.Gray{Y: uint8(math.Max(0, math.Min(255, sum)))})
+		}
+	}
+	return out
+}
+
+func BenchmarkConvolve(b *testing.B) {
+	gray := noise(2048, 2048)
+	img := imaging.ToFloat(gray)
+
+	kernels := []struct {
+		name   string
+		kernel [][]float64
+	}{
+		{"5x5", imaging.GaussianKernel5x5},
+		{"13x13", imaging.GaussianKernel(2)},
+	}
+
+	for _, k := range kernels {
+		b.Run(k.name+"/original", func(b *testing.B) {
+			for b.Loop() {
+				convolveGrayAt(gray, k.kernel)
+			}
+		})
+		b.Run(k.name+"/serial", func(b *testing.B) {
+			for b.Loop() {
+				imaging.ConvolveFloat(img, k.kernel, imaging.WithWorkers(1))
+			}
+		})
+		b.Run(k.name+"/paralelo", func(b *testing.B) {
+			for b.Loop() {
+				imaging.ConvolveFloat(img, k.kernel)
+			}
+		})
+	}
+}
+
+func BenchmarkDetectEdgesSobel(b *testing.B) {
+	img := noise(2048, 2048)
+
+	b.Run("serial", func(b *testing.B) {
+		for b.Loop() {
+			imaging.DetectEdgesSobel(img, imaging.Fixed(100), imaging.WithWorkers(1))
+		}
+	})
+	b.Run("paralelo", func(b *testing.B) {
+		for b.Loop() {
+			imaging.DetectEdgesSobel(img, imaging.Fixed(100))
+		}
+	})
+}
+
+func BenchmarkStrategy(b *testing.B) {
+	img := imaging.ToFloat(noise(1024, 1024))
+
+	kernels := []struct {
+		name   string
+		kernel [][]float64
+	}{
+		{"Gaussiano 19x19", imaging.GaussianKernel(3)},
+		{"aleatório 5x5", randomKernel(5, 5)},
+		{"aleatório 9x9", randomKernel(9, 9)},
+		{"aleatório 15x15", randomKernel(15, 15)},
+		{"LoG 33x33", imaging.LoGKernel(4)},
+	}
+	strategies := []imaging.Strategy{
+		imaging.StrategyDirect, imaging.StrategySeparable, imaging.StrategyFFT, imaging.StrategyAuto,
+	}
+
+	for _, k := range kernels {
+		_, _, separable := imaging.Separate(k.kernel)
+		for _, strategy := range strategies {
+			if strategy == imaging.StrategySeparable && !separable {
+				continue
+			}
+			b.Run(k.name+"/"+strategy.String(), func(b *testing.B) {
+				for b.Loop() {
+					imaging.ConvolveFloat(img, k.kernel, imaging.WithStrategy(strategy))
+				}
+			})
+		}
+	}
+}
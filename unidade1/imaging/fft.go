@@ -0,0 +1,134 @@
+package imaging
+
+import (
+	"context"
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/dsp/fourier"
+)
+
+// convolveFFT calcula a convolução pela FFT. A imagem é completada conforme o modo de borda,
+// de modo que a correlação circular na malha de FFT coincide com a convolução direta nos
+// pixels de saída: c[s] = Σₖ kernel[k]·P[s+k], com transformada P̂·conj(K̂).
+func convolveFFT(ctx context.Context, img *Float, kernel [][]float64, o options) (*Float, error) {
+	bounds := img.Bounds()
+	kernelHeight, kernelWidth := len(kernel), len(kernel[0])
+	radiusY, radiusX := kernelHeight/2, kernelWidth/2
+
+	outBounds := outputBounds(bounds, kernelWidth, kernelHeight, o.border)
+	out := NewFloat(outBounds)
+	if outBounds.Empty() {
+		return out, ctx.Err()
+	}
+
+	// A imagem completada começa na posição -before; com BorderCrop não há completamento.
+	beforeX, afterX := radiusX, kernelWidth-1-radiusX
+	beforeY, afterY := radiusY, kernelHeight-1-radiusY
+	if o.border == BorderCrop {
+		beforeX, afterX, beforeY, afterY = 0, 0, 0, 0
+	}
+	rows := borderIndex(bounds.Dy(), beforeY, afterY, o.border)
+	cols := borderIndex(bounds.Dx(), beforeX, afterX, o.border)
+
+	width, height := fftSize(len(cols)), fftSize(len(rows))
+
+	padded := make([]complex128, width*height)
+	for r, row := range rows {
+		if row < 0 {
+			continue
+		}
+		line := img.Pix[row*img.Stride:]
+		for c, col := range cols {
+			if col >= 0 {
+				padded[r*width+c] = complex(line[col], 0)
+			}
+		}
+	}
+
+	spectrum := make([]complex128, width*height)
+	for ky, weights := range kernel {
+		for kx, w := range weights {
+			spectrum[ky*width+kx] = complex(w, 0)
+		}
+	}
+
+	if err := fft2(ctx, padded, width, height, o.workers, false); err != nil {
+		return nil, err
+	}
+	if err := fft2(ctx, spectrum, width, height, o.workers, false); err != nil {
+		return nil, err
+	}
+	for i, k := range spectrum {
+		padded[i] *= cmplx.Conj(k)
+	}
+	if err := fft2(ctx, padded, width, height, o.workers, true); err != nil {
+		return nil, err
+	}
+
+	// O pixel de saída (x, y) lê a imagem completada a partir de (x, y) - outBounds.Min.
+	scale := 1 / float64(width*height)
+	for y := outBounds.Min.Y; y < outBounds.Max.Y; y++ {
+		src := padded[(y-outBounds.Min.Y)*width:]
+		dst := out.Pix[out.PixOffset(outBounds.Min.X, y):]
+		for x := range outBounds.Dx() {
+			dst[x] = real(src[x]) * scale
+		}
+	}
+	return out, nil
+}
+
+// fft2 aplica a FFT 2D, ou a inversa sem normalização, à malha width×height guardada linha a
+// linha em data, transformando as linhas e depois as colunas em até workers goroutines.
+func fft2(ctx context.Context, data []complex128, width, height, workers int, inverse bool) error {
+	transform := func(plan *fourier.CmplxFFT, seq []complex128) {
+		if inverse {
+			plan.Sequence(seq, seq)
+		} else {
+			plan.Coefficients(seq, seq)
+		}
+	}
+
+	rowPlans := make([]*fourier.CmplxFFT, workers)
+	err := forEach(ctx, workers, height, func(w, y int) {
+		if rowPlans[w] == nil {
+			rowPlans[w] = fourier.NewCmplxFFT(width)
+		}
+		transform(rowPlans[w], data[y*width:(y+1)*width])
+	})
+	if err != nil {
+		return err
+	}
+
+	colPlans := make([]*fourier.CmplxFFT, workers)
+	buffers := make([][]complex128, workers)
+	return forEach(ctx, workers, width, func(w, x int) {
+		if colPlans[w] == nil {
+			colPlans[w] = fourier.NewCmplxFFT(height)
+			buffers[w] = make([]complex128, height)
+		}
+		column := buffers[w]
+		for y := range height {
+			column[y] = data[y*width+x]
+		}
+		transform(colPlans[w], column)
+		for y := range height {
+			data[y*width+x] = column[y]
+		}
+	})
+}
+
+// fftSize retorna o menor tamanho ≥ n cujos únicos fatores primos são 2, 3 e 5, para os quais
+// a FFT é eficiente.
+func fftSize(n int) int {
+	for m := max(n, 1); ; m++ {
+		k := m
+		for _, p := range []int{2, 3, 5} {
+			for k%p == 0 {
+				k /= p
+			}
+		}
+		if k == 1 {
+			return m
+		}
+	}
+}
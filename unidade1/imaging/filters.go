@@ -1,5 +1,9 @@
 package imaging
 
+import (
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/stencil"
+)
+
 // GaussianKernel5x5 é um kernel de suavização Gaussiano.
 var GaussianKernel5x5 = [][]float64{
 	{1.0 / 256, 4.0 / 256, 6.0 / 256, 4.0 / 256, 1.0 / 256},
@@ -9,6 +13,22 @@ var GaussianKernel5x5 = [][]float64{
 	{1.0 / 256, 4.0 / 256, 6.0 / 256, 4.0 / 256, 1.0 / 256},
 }
 
+// GaussianKernel monta o kernel Gaussiano normalizado de desvio padrão sigma, com raio ⌈3σ⌉,
+// o produto externo de GaussianSmoothing por ela mesma. Entra em pânico se sigma não for
+// positivo.
+func GaussianKernel(sigma float64) [][]float64 {
+	weights := GaussianSmoothing(sigma)
+
+	kernel := make([][]float64, len(weights))
+	for i := range kernel {
+		kernel[i] = make([]float64, len(weights))
+		for j := range kernel[i] {
+			kernel[i][j] = weights[i] * weights[j]
+		}
+	}
+	return kernel
+}
+
 // SobelX detecta bordas verticais (derivada em X).
 var SobelX = [][]float64{
 	{-1, 0, 1},
@@ -30,50 +50,43 @@ var Laplacian = [][]float64{
 	{0, 1, 0},
 }
 
-var CentralO4X = [][]float64{
-	{1, -8, 0, 8, -1},
-	{1, -8, 0, 8, -1},
-	{1, -8, 0, 8, -1},
-	{1, -8, 0, 8, -1},
-	{1, -8, 0, 8, -1},
-}
-
-var CentralO4Y = [][]float64{
-	{1, 1, 1, 1, 1},
-	{-8, -8, -8, -8, -8},
-	{0, 0, 0, 0, 0},
-	{8, 8, 8, 8, 8},
-	{-1, -1, -1, -1, -1},
-}
+// CentralO4X e CentralO4Y aplicam a primeira derivada central O(h⁴) em X e em Y, com a média
+// de 5 pixels na direção perpendicular. Os pesos já estão divididos pelo divisor da fórmula;
+// os kernels anteriores somavam as 5 linhas em vez de tirar a média, e respondiam 5 vezes a
+// derivada.
+var CentralO4X, CentralO4Y = CentralO4Operator.X, CentralO4Operator.Y
 
-var ForwardO4X = [][]float64{
-	{-25, 48, -36, 16, -3},
-	{-25, 48, -36, 16, -3},
-	{-25, 48, -36, 16, -3},
-	{-25, 48, -36, 16, -3},
-	{-25, 48, -36, 16, -3},
-}
+// ForwardO4X e ForwardO4Y aplicam a primeira derivada progressiva O(h⁴) em X e em Y. São 9×9,
+// com a fórmula nos pixels x, ..., x+4; os kernels 5×5 anteriores centravam a fórmula e
+// deslocavam a resposta em 2 pixels.
+var ForwardO4X, ForwardO4Y = ForwardO4Operator.X, ForwardO4Operator.Y
 
-var ForwardO4Y = [][]float64{
-	{-25, -25, -25, -25, -25},
-	{48, 48, 48, 48, 48},
-	{-36, -36, -36, -36, -36},
-	{16, 16, 16, 16, 16},
-	{-3, -3, -3, -3, -3},
-}
+// BackwardO4X e BackwardO4Y aplicam a primeira derivada regressiva O(h⁴) em X e em Y, nos
+// pixels x-4, ..., x, como ForwardO4X.
+var BackwardO4X, BackwardO4Y = BackwardO4Operator.X, BackwardO4Operator.Y
 
-var BackwardO4X = [][]float64{
-	{3, -16, 36, -48, 25},
-	{3, -16, 36, -48, 25},
-	{3, -16, 36, -48, 25},
-	{3, -16, 36, -48, 25},
-	{3, -16, 36, -48, 25},
+// GradientOperator é um par de kernels de derivada em X e em Y, com a escala que leva a
+// magnitude da resposta de volta às unidades da derivada. NewGradientOperator monta
+// operadores a partir de qualquer fórmula de diferenças finitas.
+type GradientOperator struct {
+	// Name identifica o operador nos logs.
+	Name string
+	// X e Y são os kernels de derivada em X e em Y.
+	X, Y [][]float64
+	// Scale multiplica a magnitude do gradiente.
+	Scale float64
 }
 
-var BackwardO4Y = [][]float64{
-	{3, 3, 3, 3, 3},
-	{-16, -16, -16, -16, -16},
-	{36, 36, 36, 36, 36},
-	{-48, -48, -48, -48, -48},
-	{25, 25, 25, 25, 25},
-}
+var (
+	// SobelOperator usa os kernels de Sobel.
+	SobelOperator = GradientOperator{Name: "Sobel", X: SobelX, Y: SobelY, Scale: 1}
+	// CentralO4Operator usa a primeira derivada central O(h⁴), com a média de 5 pixels na
+	// direção perpendicular.
+	CentralO4Operator = mustGradientOperator("Central O(h⁴)", stencil.Central, 4, BoxSmoothing(5))
+	// ForwardO4Operator usa a primeira derivada progressiva O(h⁴), com a média de 5 pixels
+	// na direção perpendicular.
+	ForwardO4Operator = mustGradientOperator("Forward O(h⁴)", stencil.Forward, 4, BoxSmoothing(5))
+	// BackwardO4Operator usa a primeira derivada regressiva O(h⁴), com a média de 5 pixels
+	// na direção perpendicular.
+	BackwardO4Operator = mustGradientOperator("Backward O(h⁴)", stencil.Backward, 4, BoxSmoothing(5))
+)
@@ -0,0 +1,144 @@
+package imaging
+
+import (
+	"image"
+	"math"
+)
+
+// Float é uma imagem de um canal com valores float64. Ao contrário de image.Gray, os valores
+// não são limitados a 0–255, de modo que as respostas dos kernels de derivada mantêm o sinal
+// e a magnitude até a binarização ou normalização final.
+type Float struct {
+	// Pix guarda os valores linha a linha; o pixel (x, y) está em Pix[(y-Rect.Min.Y)*Stride + (x-Rect.Min.X)].
+	Pix []float64
+	// Stride é a distância em Pix entre pixels verticalmente adjacentes.
+	Stride int
+	// Rect são os limites da imagem.
+	Rect image.Rectangle
+}
+
+// NewFloat cria uma imagem nula com os limites r.
+func NewFloat(r image.Rectangle) *Float {
+	return &Float{
+		Pix:    make([]float64, r.Dx()*r.Dy()),
+		Stride: r.Dx(),
+		Rect:   r,
+	}
+}
+
+// ToFloat converte uma imagem em tons de cinza, mantendo os limites e os valores 0–255.
+func ToFloat(img *image.Gray) *Float {
+	bounds := img.Bounds()
+	f := NewFloat(bounds)
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		src := img.Pix[img.PixOffset(bounds.Min.X, y):]
+		dst := f.Pix[f.PixOffset(bounds.Min.X, y):]
+		for x := range bounds.Dx() {
+			dst[x] = float64(src[x])
+		}
+	}
+	return f
+}
+
+// Bounds retorna os limites da imagem.
+func (f *Float) Bounds() image.Rectangle {
+	return f.Rect
+}
+
+// PixOffset retorna o índice em Pix do pixel (x, y).
+func (f *Float) PixOffset(x, y int) int {
+	return (y-f.Rect.Min.Y)*f.Stride + (x - f.Rect.Min.X)
+}
+
+// At retorna o valor do pixel (x, y), ou 0 fora dos limites.
+func (f *Float) At(x, y int) float64 {
+	if !(image.Point{X: x, Y: y}.In(f.Rect)) {
+		return 0
+	}
+	return f.Pix[f.PixOffset(x, y)]
+}
+
+// Set altera o valor do pixel (x, y); fora dos limites não faz nada.
+func (f *Float) Set(x, y int, v float64) {
+	if !(image.Point{X: x, Y: y}.In(f.Rect)) {
+		return
+	}
+	f.Pix[f.PixOffset(x, y)] = v
+}
+
+// Gray converte a imagem para tons de cinza, limitando os valores a 0–255.
+func (f *Float) Gray() *image.Gray {
+	return f.toGray(func(v float64) float64 { return v })
+}
+
+// Normalize converte a imagem para tons de cinza, levando linearmente o menor valor a 0 e o
+// maior a 255. Uma imagem constante vira preta.
+func (f *Float) Normalize() *image.Gray {
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for _, v := range f.Pix {
+		lo = min(lo, v)
+		hi = max(hi, v)
+	}
+	if !(hi > lo) {
+		return f.toGray(func(float64) float64 { return 0 })
+	}
+
+	scale := 255 / (hi - lo)
+	return f.toGray(func(v float64) float64 { return (v - lo) * scale })
+}
+
+// toGray converte a imagem para tons de cinza aplicando transform e limitando a 0–255.
+func (f *Float) toGray(transform func(float64) float64) *image.Gray {
+	out := image.NewGray(f.Rect)
+	for y := f.Rect.Min.Y; y < f.Rect.Max.Y; y++ {
+		src := f.Pix[f.PixOffset(f.Rect.Min.X, y):]
+		dst := out.Pix[out.PixOffset(f.Rect.Min.X, y):]
+		for x := range f.Rect.Dx() {
+			dst[x] = uint8(math.Max(0, math.Min(255, transform(src[x]))))
+		}
+	}
+	return out
+}
+
+// values retorna uma cópia dos valores dos pixels, linha a linha. É sempre uma cópia, pois
+// vai para ThresholdStrategy.Select, que pode alterá-la.
+func (f *Float) values() []float64 {
+	values := make([]float64, 0, f.Rect.Dx()*f.Rect.Dy())
+	for y := f.Rect.Min.Y; y < f.Rect.Max.Y; y++ {
+		i := f.PixOffset(f.Rect.Min.X, y)
+		values = append(values, f.Pix[i:i+f.Rect.Dx()]...)
+	}
+	return values
+}
+
+// Magnitude retorna √(gx² + gy²)·scale em cada pixel. As imagens devem ter os mesmos limites.
+func Magnitude(gx, gy *Float, scale float64) *Float {
+	out := NewFloat(gx.Rect)
+	for y := out.Rect.Min.Y; y < out.Rect.Max.Y; y++ {
+		a := gx.Pix[gx.PixOffset(out.Rect.Min.X, y):]
+		b := gy.Pix[gy.PixOffset(out.Rect.Min.X, y):]
+		dst := out.Pix[out.PixOffset(out.Rect.Min.X, y):]
+		for x := range out.Rect.Dx() {
+			dst[x] = math.Hypot(a[x], b[x]) * scale
+		}
+	}
+	return out
+}
+
+// Threshold binariza a imagem: pixels com valor acima de threshold viram borda (preto) e os
+// demais, fundo (branco). Para escolher o limiar a partir da imagem, veja ThresholdStrategy.
+func Threshold(f *Float, threshold float64) *image.Gray {
+	out := image.NewGray(f.Rect)
+	for y := f.Rect.Min.Y; y < f.Rect.Max.Y; y++ {
+		src := f.Pix[f.PixOffset(f.Rect.Min.X, y):]
+		dst := out.Pix[out.PixOffset(f.Rect.Min.X, y):]
+		for x := range f.Rect.Dx() {
+			if src[x] > threshold {
+				dst[x] = 0 // Borda (preto)
+			} else {
+				dst[x] = 255 // Fundo (branco)
+			}
+		}
+	}
+	return out
+}
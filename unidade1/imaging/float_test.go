@@ -0,0 +1,95 @@
+package imaging_test
+
+import (
+	"image"
+	"testing"
+
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/imaging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// step monta uma imagem com o valor left à esquerda da coluna edge e right a partir dela.
+func step(width, height, edge int, left, right uint8) *image.Gray {
+	img := image.NewGray(image.Rect(0, 0, width, height))
+	for y := range height {
+		for x := range width {
+			if x < edge {
+				img.Pix[img.PixOffset(x, y)] = left
+			} else {
+				img.Pix[img.PixOffset(x, y)] = right
+			}
+		}
+	}
+	return img
+}
+
+func TestFloat_Conversion(t *testing.T) {
+	img := image.NewGray(image.Rect(3, 2, 7, 5))
+	for i := range img.Pix {
+		img.Pix[i] = uint8(20 * i)
+	}
+
+	f := imaging.ToFloat(img)
+	require.Equal(t, img.Bounds(), f.Bounds())
+	assert.Equal(t, 0.0, f.At(3, 2))
+	assert.Equal(t, 140.0, f.At(6, 3))
+	assert.Equal(t, 0.0, f.At(0, 0), "fora dos limites")
+
+	assert.Equal(t, img, f.Gray())
+
+	f.Set(3, 2, -40)
+	f.Set(4, 2, 400)
+	g := f.Gray()
+	assert.Equal(t, uint8(0), g.GrayAt(3, 2).Y)
+	assert.Equal(t, uint8(255), g.GrayAt(4, 2).Y)
+
+	n := f.Normalize()
+	assert.Equal(t, uint8(0), n.GrayAt(3, 2).Y)
+	assert.Equal(t, uint8(255), n.GrayAt(4, 2).Y)
+
+	constant := imaging.NewFloat(image.Rect(0, 0, 2, 2))
+	assert.Equal(t, make([]uint8, 4), constant.Normalize().Pix)
+}
+
+func TestConvolveFloat_Sign(t *testing.T) {
+	// Numa borda de claro para escuro a derivada é negativa: Convolve a perde, ConvolveFloat não.
+	img := step(8, 3, 4, 200, 50)
+	kernel := [][]float64{{-1, 0, 1}}
+
+	got := imaging.ConvolveFloat(imaging.ToFloat(img), kernel)
+	assert.Equal(t, -150.0, got.At(3, 1))
+	assert.Equal(t, -150.0, got.At(4, 1))
+	assert.Equal(t, 0.0, got.At(1, 1))
+
+	clamped := imaging.Convolve(img, kernel)
+	assert.Equal(t, uint8(0), clamped.GrayAt(3, 1).Y)
+}
+
+func TestDetectEdges_FallingEdge(t *testing.T) {
+	// As duas bordas têm o mesmo contraste e devem ser detectadas igualmente.
+	rising := step(16, 8, 8, 50, 200)
+	falling := step(16, 8, 8, 200, 50)
+
+	detectors := []struct {
+		name      string
+		detect    func(*image.Gray, imaging.ThresholdStrategy, ...imaging.Option) (*image.Gray, float64)
+		threshold imaging.ThresholdStrategy
+	}{
+		{"Sobel", imaging.DetectEdgesSobel, imaging.Fixed(100)},
+		{"Laplaciano", imaging.DetectEdgesLaplacian, imaging.Fixed(5)},
+		{"Central O(h⁴)", imaging.DetectEdgesCentralO4, imaging.Fixed(15)},
+	}
+
+	for _, d := range detectors {
+		t.Run(d.name, func(t *testing.T) {
+			up, _ := d.detect(rising, d.threshold)
+			down, _ := d.detect(falling, d.threshold)
+
+			assert.Equal(t, up.Pix, down.Pix)
+			// O degrau fica entre as colunas 7 e 8; os detectores de bordas finas marcam só uma.
+			assert.True(t, down.GrayAt(7, 4).Y == 0 || down.GrayAt(8, 4).Y == 0, "a borda deve ser detectada")
+			assert.Equal(t, uint8(255), down.GrayAt(1, 4).Y, "longe da borda não há borda")
+		})
+	}
+}
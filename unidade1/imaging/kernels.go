@@ -0,0 +1,207 @@
+package imaging
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/stencil"
+)
+
+// ErrOffGrid indica um estêncil com deslocamentos fracionários, que não caem sobre os pixels.
+var ErrOffGrid = errors.New("imaging: estêncil fora da malha de pixels")
+
+// BoxSmoothing retorna a suavização por média simples de n pixels. Entra em pânico se n não
+// for ímpar e positivo.
+func BoxSmoothing(n int) []float64 {
+	mustOddLength(n)
+
+	weights := make([]float64, n)
+	for i := range weights {
+		weights[i] = 1 / float64(n)
+	}
+	return weights
+}
+
+// BinomialSmoothing retorna a suavização binomial de n pixels, a linha n-1 do triângulo de
+// Pascal normalizada: com n = 3, (1, 2, 1)/4, a suavização de Sobel. Entra em pânico se n
+// não for ímpar e positivo.
+func BinomialSmoothing(n int) []float64 {
+	mustOddLength(n)
+
+	weights := make([]float64, n)
+	weights[0] = 1
+	for i := 1; i < n; i++ {
+		weights[i] = weights[i-1] * float64(n-i) / float64(i)
+	}
+
+	total := math.Pow(2, float64(n-1))
+	for i := range weights {
+		weights[i] /= total
+	}
+	return weights
+}
+
+// GaussianSmoothing retorna a suavização Gaussiana normalizada de desvio padrão sigma, com
+// raio ⌈3σ⌉. Entra em pânico se sigma não for positivo.
+func GaussianSmoothing(sigma float64) []float64 {
+	if !(sigma > 0) || math.IsInf(sigma, 0) {
+		panic(fmt.Sprintf("imaging: desvio padrão inválido: %g", sigma))
+	}
+
+	radius := max(1, int(math.Ceil(3*sigma)))
+	weights := make([]float64, 2*radius+1)
+	var total float64
+	for i := range weights {
+		d := float64(i - radius)
+		weights[i] = math.Exp(-d * d / (2 * sigma * sigma))
+		total += weights[i]
+	}
+	for i := range weights {
+		weights[i] /= total
+	}
+	return weights
+}
+
+// mustOddLength entra em pânico se n não for um comprimento de suavização válido.
+func mustOddLength(n int) {
+	if n < 1 || n%2 == 0 {
+		panic(fmt.Sprintf("imaging: comprimento de suavização inválido: %d", n))
+	}
+}
+
+// DerivativeKernels monta os kernels em X e em Y da derivada de ordem derivative pela fórmula
+// de diferenças finitas da filosofia p (stencil.Forward, stencil.Backward ou stencil.Central)
+// com erro O(h^errorOrder). Ao contrário de stencil.NewCentral, as fórmulas centrais usam
+// sempre uma quantidade ímpar de pontos (stencil.CentralPoints), para que caiam sobre os
+// pixels; a ordem de erro obtida pode então ser maior que a pedida. Veja StencilKernels.
+func DerivativeKernels(derivative uint64, p stencil.Philosophy, errorOrder uint64, smoothing []float64) (x, y [][]float64, err error) {
+	if derivative == 0 || errorOrder == 0 {
+		return nil, nil, fmt.Errorf("imaging: ordens inválidas: derivada %d, erro %d", derivative, errorOrder)
+	}
+	if p == stencil.HalfStep {
+		return nil, nil, fmt.Errorf("%w: filosofia %s", ErrOffGrid, p)
+	}
+
+	points := int(derivative + errorOrder)
+	if p == stencil.Central {
+		points = stencil.CentralPoints(derivative, errorOrder)
+	}
+
+	offsets, err := stencil.Offsets(p, points)
+	if err != nil {
+		return nil, nil, err
+	}
+	s, err := stencil.New(derivative, offsets)
+	if err != nil {
+		return nil, nil, err
+	}
+	return StencilKernels(s, smoothing)
+}
+
+// StencilKernels monta os kernels em X e em Y de uma fórmula de diferenças finitas qualquer,
+// como as dos pacotes first, second e stencil. Os pesos da fórmula já vêm divididos pelo
+// divisor, de modo que a resposta está nas unidades da derivada (com h = 1 pixel): aplicado a
+// f(x, y) = x, o kernel em X responde 1 em todos os pixels.
+//
+// Na direção da derivada, o peso do deslocamento o fica a o pixels do centro, e as fórmulas
+// unilaterais são completadas com zeros do outro lado. Na direção perpendicular, a derivada é
+// suavizada pelos pesos smoothing, que devem somar 1 e ter comprimento ímpar; nil não suaviza.
+// Os kernels são quadrados, completados com zeros, e o kernel em Y é o transposto do em X.
+//
+// Retorna ErrOffGrid se a fórmula tiver deslocamentos fracionários e ErrInvalidOption se a
+// suavização tiver comprimento par.
+func StencilKernels(d stencil.Describer, smoothing []float64) (x, y [][]float64, err error) {
+	row, err := stencilRow(d.Describe())
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(smoothing) == 0 {
+		smoothing = []float64{1}
+	}
+	if len(smoothing)%2 == 0 {
+		return nil, nil, fmt.Errorf("%w: suavização com %d pesos, o comprimento deve ser ímpar",
+			ErrInvalidOption, len(smoothing))
+	}
+
+	size := max(len(row), len(smoothing))
+	x = make([][]float64, size)
+	y = make([][]float64, size)
+	for i := range size {
+		x[i] = make([]float64, size)
+		y[i] = make([]float64, size)
+	}
+
+	// x[i][j] = smoothing[i]·row[j], centrados no kernel.
+	di, dj := (size-len(smoothing))/2, (size-len(row))/2
+	for i, s := range smoothing {
+		for j, w := range row {
+			x[di+i][dj+j] = s * w
+			y[dj+j][di+i] = s * w
+		}
+	}
+	return x, y, nil
+}
+
+// LaplacianKernel monta o kernel do Laplaciano ∂²/∂x² + ∂²/∂y² pela segunda derivada da
+// filosofia p com erro O(h^errorOrder), somando os kernels em X e em Y de DerivativeKernels.
+// Com a fórmula central O(h²) e sem suavização, é o kernel Laplacian.
+func LaplacianKernel(p stencil.Philosophy, errorOrder uint64, smoothing []float64) ([][]float64, error) {
+	x, y, err := DerivativeKernels(2, p, errorOrder, smoothing)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range x {
+		for j := range x[i] {
+			x[i][j] += y[i][j]
+		}
+	}
+	return x, nil
+}
+
+// NewGradientOperator monta o operador de gradiente da primeira derivada da filosofia p com
+// erro O(h^errorOrder) e suavização perpendicular smoothing, como em DerivativeKernels. Os
+// kernels já estão nas unidades da derivada, então a escala é 1.
+func NewGradientOperator(p stencil.Philosophy, errorOrder uint64, smoothing []float64) (GradientOperator, error) {
+	x, y, err := DerivativeKernels(1, p, errorOrder, smoothing)
+	if err != nil {
+		return GradientOperator{}, err
+	}
+
+	return GradientOperator{
+		Name:  fmt.Sprintf("%s O(h^%d)", p, errorOrder),
+		X:     x,
+		Y:     y,
+		Scale: 1,
+	}, nil
+}
+
+// mustGradientOperator monta um operador de gradiente com o nome dado, entrando em pânico
+// em caso de erro.
+func mustGradientOperator(name string, p stencil.Philosophy, errorOrder uint64, smoothing []float64) GradientOperator {
+	op, err := NewGradientOperator(p, errorOrder, smoothing)
+	if err != nil {
+		panic(err.Error())
+	}
+	op.Name = name
+	return op
+}
+
+// stencilRow retorna os pesos da fórmula dispostos numa linha centrada no deslocamento 0,
+// com 2R+1 posições, em que R é o maior deslocamento em valor absoluto.
+func stencilRow(d stencil.Description) ([]float64, error) {
+	var radius int
+	for _, o := range d.Offsets {
+		if o != math.Trunc(o) {
+			return nil, fmt.Errorf("%w: deslocamento %g", ErrOffGrid, o)
+		}
+		radius = max(radius, int(math.Abs(o)))
+	}
+
+	row := make([]float64, 2*radius+1)
+	for i, w := range d.Weights() {
+		row[radius+int(d.Offsets[i])] = w
+	}
+	return row, nil
+}
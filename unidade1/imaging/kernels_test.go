@@ -0,0 +1,191 @@
+package imaging_test
+
+import (
+	"fmt"
+	"image"
+	"testing"
+
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/first"
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/derivatives/stencil"
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/imaging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// correlate aplica o kernel à função f no pixel (x, y), como a convolução do pacote.
+func correlate(kernel [][]float64, f func(x, y float64) float64, x, y int) float64 {
+	ry, rx := len(kernel)/2, len(kernel[0])/2
+	var sum float64
+	for ky := range kernel {
+		for kx, w := range kernel[ky] {
+			sum += w * f(float64(x-rx+kx), float64(y-ry+ky))
+		}
+	}
+	return sum
+}
+
+func TestSmoothing(t *testing.T) {
+	assert.Equal(t, []float64{0.25, 0.5, 0.25}, imaging.BinomialSmoothing(3))
+	assert.Equal(t, []float64{1}, imaging.BinomialSmoothing(1))
+	assert.InDeltaSlice(t, []float64{0.2, 0.2, 0.2, 0.2, 0.2}, imaging.BoxSmoothing(5), 1e-15)
+
+	for name, weights := range map[string][]float64{
+		"binomial 7":  imaging.BinomialSmoothing(7),
+		"gaussiana 1": imaging.GaussianSmoothing(1),
+	} {
+		var total float64
+		for _, w := range weights {
+			total += w
+		}
+		assert.InDelta(t, 1, total, 1e-15, name)
+	}
+
+	assert.Panics(t, func() { imaging.BoxSmoothing(4) })
+	assert.Panics(t, func() { imaging.BinomialSmoothing(0) })
+	assert.Panics(t, func() { imaging.GaussianSmoothing(0) })
+}
+
+func TestDerivativeKernels_Sobel(t *testing.T) {
+	// Sobel é a derivada central O(h²) com suavização binomial, multiplicada por 8.
+	x, y, err := imaging.DerivativeKernels(1, stencil.Central, 2, imaging.BinomialSmoothing(3))
+	require.NoError(t, err)
+	for i := range 3 {
+		for j := range 3 {
+			assert.InDelta(t, imaging.SobelX[i][j], 8*x[i][j], 1e-15)
+			assert.InDelta(t, imaging.SobelY[i][j], 8*y[i][j], 1e-15)
+		}
+	}
+}
+
+func TestLaplacianKernel(t *testing.T) {
+	k, err := imaging.LaplacianKernel(stencil.Central, 2, nil)
+	require.NoError(t, err)
+	assert.Equal(t, imaging.Laplacian, k)
+
+	// O(h⁴) usa 5 pontos: -1/12, 4/3, -5/2, 4/3, -1/12 em cada direção.
+	k, err = imaging.LaplacianKernel(stencil.Central, 4, nil)
+	require.NoError(t, err)
+	require.Len(t, k, 5)
+	assert.InDelta(t, -5.0, k[2][2], 1e-14)
+	assert.InDelta(t, -1.0/12, k[0][2], 1e-15)
+	assert.Zero(t, k[0][0])
+}
+
+func TestDerivativeKernels_Exact(t *testing.T) {
+	// As fórmulas são exatas para polinômios de grau menor que a quantidade de pontos, e a
+	// suavização simétrica preserva os termos lineares na direção perpendicular.
+	type polynomial struct {
+		f      func(x, y float64) float64
+		fx, fy func(x, y float64) float64
+	}
+	polynomials := map[uint64]polynomial{
+		1: {
+			f:  func(x, y float64) float64 { return x*x + 3*x*y + y },
+			fx: func(x, y float64) float64 { return 2*x + 3*y },
+			fy: func(x, y float64) float64 { return 3*x + 1 },
+		},
+		2: {
+			f:  func(x, y float64) float64 { return x*x*x + x*x*y + y*y*y },
+			fx: func(x, y float64) float64 { return 6*x + 2*y },
+			fy: func(x, y float64) float64 { return 6 * y },
+		},
+	}
+	philosophies := []stencil.Philosophy{stencil.Forward, stencil.Backward, stencil.Central}
+	smoothings := map[string][]float64{
+		"sem":         nil,
+		"média 3":     imaging.BoxSmoothing(3),
+		"binomial 5":  imaging.BinomialSmoothing(5),
+		"gaussiana 1": imaging.GaussianSmoothing(1),
+	}
+
+	for derivative, p := range polynomials {
+		for _, philosophy := range philosophies {
+			for order := uint64(2); order <= 4; order++ {
+				for name, smoothing := range smoothings {
+					t.Run(fmt.Sprintf("d%d/%s/O(h^%d)/%s", derivative, philosophy, order, name), func(t *testing.T) {
+						x, y, err := imaging.DerivativeKernels(derivative, philosophy, order, smoothing)
+						require.NoError(t, err)
+						require.Len(t, x, len(x[0]), "o kernel é quadrado")
+
+						assert.InDelta(t, p.fx(10, 7), correlate(x, p.f, 10, 7), 1e-9)
+						assert.InDelta(t, p.fy(10, 7), correlate(y, p.f, 10, 7), 1e-9)
+					})
+				}
+			}
+		}
+	}
+}
+
+func TestDerivativeKernels_Placement(t *testing.T) {
+	// A fórmula progressiva usa os pixels x, ..., x+4: o kernel tem 9 colunas, com a metade
+	// esquerda nula.
+	x, _, err := imaging.DerivativeKernels(1, stencil.Forward, 4, imaging.BoxSmoothing(5))
+	require.NoError(t, err)
+	require.Len(t, x, 9)
+	assert.Equal(t, []float64{0, 0, 0, 0, 0, 0, 0, 0, 0}, x[1])
+	assert.Equal(t, []float64{0, 0, 0, 0}, x[2][:4])
+	assert.InDelta(t, -25.0/12/5, x[2][4], 1e-15)
+
+	// A fórmula central de ordem 3 usa 5 pontos, e não os meio-passos de stencil.NewCentral.
+	x, _, err = imaging.DerivativeKernels(1, stencil.Central, 3, nil)
+	require.NoError(t, err)
+	require.Len(t, x, 5)
+
+	// As descrições dos pacotes de derivadas dão os mesmos kernels.
+	fromFirst, _, err := imaging.StencilKernels(first.NewBackward(4), imaging.BoxSmoothing(5))
+	require.NoError(t, err)
+	assert.Equal(t, imaging.BackwardO4X, fromFirst)
+}
+
+func TestCentralO4Operator_Scale(t *testing.T) {
+	// Os kernels 5×5 anteriores repetiam os coeficientes em 5 linhas, com escala 1/12: a
+	// magnitude era 5 vezes a atual, que é a própria derivada.
+	desc := first.NewCentral(4).Describe()
+	legacy := make([][]float64, 5)
+	for i := range legacy {
+		legacy[i] = desc.Coefficients
+	}
+
+	ramp := func(x, y float64) float64 { return 3*x + y*y }
+	got := correlate(imaging.CentralO4X, ramp, 10, 7) * imaging.CentralO4Operator.Scale
+	assert.InDelta(t, 3, got, 1e-12)
+	assert.InDelta(t, 5*got, correlate(legacy, ramp, 10, 7)/desc.Divisor, 1e-12)
+}
+
+func TestDerivativeKernels_Errors(t *testing.T) {
+	_, _, err := imaging.DerivativeKernels(1, stencil.HalfStep, 2, nil)
+	assert.ErrorIs(t, err, imaging.ErrOffGrid)
+
+	_, _, err = imaging.StencilKernels(stencil.NewCentral(1, 3), nil)
+	assert.ErrorIs(t, err, imaging.ErrOffGrid)
+
+	_, _, err = imaging.DerivativeKernels(1, stencil.Central, 2, []float64{0.5, 0.5})
+	assert.ErrorIs(t, err, imaging.ErrInvalidOption)
+
+	_, _, err = imaging.DerivativeKernels(0, stencil.Central, 2, nil)
+	assert.Error(t, err)
+
+	_, err = imaging.NewGradientOperator(stencil.Forward, 0, nil)
+	assert.Error(t, err)
+}
+
+func TestNewGradientOperator(t *testing.T) {
+	op, err := imaging.NewGradientOperator(stencil.Central, 6, imaging.GaussianSmoothing(1))
+	require.NoError(t, err)
+	assert.Equal(t, "central O(h^6)", op.Name)
+	assert.Equal(t, 1.0, op.Scale)
+	require.Len(t, op.X, 7)
+
+	// Numa rampa de inclinação 3 em Y, a magnitude é 3.
+	img := imaging.NewFloat(image.Rect(0, 0, 16, 16))
+	for y := range 16 {
+		for x := range 16 {
+			img.Set(x, y, 3*float64(y))
+		}
+	}
+	opts := imaging.WithBorder(imaging.BorderCrop)
+	magnitude := imaging.Magnitude(imaging.ConvolveFloat(img, op.X, opts), imaging.ConvolveFloat(img, op.Y, opts), op.Scale)
+	for _, v := range magnitude.Pix {
+		assert.InDelta(t, 3, v, 1e-12)
+	}
+}
@@ -2,42 +2,24 @@ package imaging
 
 import (
 	"image"
-	"image/color"
-	"math"
 )
 
-// DetectEdgesSobel implementa o Algoritmo 1.
-func DetectEdgesSobel(inputImg *image.Gray, threshold float64) *image.Gray {
+// DetectEdgesSobel implementa o Algoritmo 1. O limiar da magnitude do gradiente vem da
+// estratégia threshold e é retornado junto com as bordas.
+func DetectEdgesSobel(inputImg *image.Gray, threshold ThresholdStrategy, opts ...Option) (*image.Gray, float64) {
 	// 1) Suavize a imagem
-	blurred := Convolve(inputImg, GaussianKernel5x5)
+	blurred := ConvolveFloat(ToFloat(inputImg), GaussianKernel5x5, opts...)
 
 	// 2.1) Aplique Sobel X
-	imgA := Convolve(blurred, SobelX)
+	imgA := ConvolveFloat(blurred, SobelX, opts...)
 
 	// 2.2) Aplique Sobel Y
-	imgB := Convolve(blurred, SobelY)
+	imgB := ConvolveFloat(blurred, SobelY, opts...)
 
-	bounds := inputImg.Bounds()
-	width, height := bounds.Dx(), bounds.Dy()
-	finalImg := image.NewGray(bounds)
+	// 2.3) Calcule a magnitude do gradiente: C = sqrt(A² + B²). Os gradientes negativos
+	// (bordas de claro para escuro) contam tanto quanto os positivos.
+	magnitude := Magnitude(imgA, imgB, 1)
 
-	// 2.3 e 2.4) Calcule a magnitude do gradiente e aplique o threshold
-	for y := range height {
-		for x := range width {
-			// Valor do gradiente em X e Y
-			valA := float64(imgA.GrayAt(x, y).Y)
-			valB := float64(imgB.GrayAt(x, y).Y)
-
-			// Magnitude: C = sqrt(A² + B²)
-			magnitude := math.Sqrt(valA*valA + valB*valB)
-
-			// 4) Gere a matriz final
-			if magnitude > threshold {
-				finalImg.SetGray(x, y, color.Gray{Y: 0}) // Borda (preto)
-			} else {
-				finalImg.SetGray(x, y, color.Gray{Y: 255}) // Fundo (branco)
-			}
-		}
-	}
-	return finalImg
+	// 2.4 e 4) Escolha e aplique o threshold e gere a matriz final
+	return binarize(magnitude, threshold)
 }
@@ -0,0 +1,142 @@
+package imaging
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"math"
+)
+
+// separableTolerance é o maior erro relativo aceito ao reconstruir um kernel separável a
+// partir dos seus fatores.
+const separableTolerance = 1e-12
+
+// fftCost é o custo estimado da convolução pela FFT por pixel e por log₂ da quantidade de
+// pontos da malha, em multiplicações do caminho direto. Medido com BenchmarkStrategy: numa
+// imagem de 1024×1024 a FFT empata com kernels diretos de 17×17.
+const fftCost = 14.0
+
+// Strategy define o algoritmo da convolução.
+type Strategy int
+
+const (
+	// StrategyAuto escolhe a estratégia mais rápida: duas passadas 1D para kernels separáveis,
+	// FFT para kernels grandes não separáveis e a definição nos demais casos. É o padrão.
+	StrategyAuto Strategy = iota
+	// StrategyDirect soma kernel[ky][kx]·pixel para cada pixel de saída.
+	StrategyDirect
+	// StrategySeparable aplica um kernel de posto 1, coluna⊗linha, como uma passada horizontal
+	// seguida de uma vertical. Falha com ErrInvalidOption se o kernel não for separável.
+	StrategySeparable
+	// StrategyFFT multiplica os espectros da imagem, já completada conforme o modo de borda, e
+	// do kernel. O resultado difere do direto apenas pelo arredondamento.
+	StrategyFFT
+)
+
+// String retorna o nome da estratégia.
+func (s Strategy) String() string {
+	switch s {
+	case StrategyAuto:
+		return "automática"
+	case StrategyDirect:
+		return "direta"
+	case StrategySeparable:
+		return "separável"
+	case StrategyFFT:
+		return "FFT"
+	default:
+		return fmt.Sprintf("Strategy(%d)", int(s))
+	}
+}
+
+// WithStrategy escolhe o algoritmo da convolução. O padrão é StrategyAuto.
+func WithStrategy(strategy Strategy) Option {
+	return func(o *options) {
+		o.strategy = strategy
+	}
+}
+
+// Separate verifica se o kernel tem posto 1, isto é, kernel[i][j] = column[i]·row[j], e
+// retorna os fatores. Os fatores são tirados do próprio kernel, na linha e na coluna do maior
+// elemento em valor absoluto, de modo que kernels inteiros como Sobel têm fatores exatos.
+func Separate(kernel [][]float64) (column, row []float64, ok bool) {
+	if len(kernel) == 0 || len(kernel[0]) == 0 {
+		return nil, nil, false
+	}
+
+	// O pivô é o elemento de maior valor absoluto.
+	pi, pj, largest := 0, 0, 0.0
+	for i := range kernel {
+		if len(kernel[i]) != len(kernel[0]) {
+			return nil, nil, false
+		}
+		for j, v := range kernel[i] {
+			if math.Abs(v) > largest {
+				pi, pj, largest = i, j, math.Abs(v)
+			}
+		}
+	}
+
+	column = make([]float64, len(kernel))
+	row = make([]float64, len(kernel[0]))
+	if largest == 0 {
+		return column, row, true
+	}
+
+	// O sinal fica na linha: Sobel X vira (1, 2, 1) ⊗ (-1, 0, 1).
+	sign := math.Copysign(1, kernel[pi][pj])
+	for i := range column {
+		column[i] = kernel[i][pj] * sign
+	}
+	for j := range row {
+		row[j] = kernel[pi][j] / largest
+	}
+
+	// Um kernel de posto maior não é reconstruído pelos fatores.
+	for i := range kernel {
+		for j, v := range kernel[i] {
+			if math.Abs(v-column[i]*row[j]) > separableTolerance*largest {
+				return nil, nil, false
+			}
+		}
+	}
+	return column, row, true
+}
+
+// chooseStrategy escolhe a estratégia mais barata para convoluir uma imagem com limites
+// bounds pelo kernel.
+func chooseStrategy(bounds image.Rectangle, kernel [][]float64) Strategy {
+	kernelHeight, kernelWidth := len(kernel), len(kernel[0])
+	if kernelHeight == 1 || kernelWidth == 1 {
+		return StrategyDirect
+	}
+	if _, _, ok := Separate(kernel); ok {
+		return StrategySeparable
+	}
+
+	// O caminho direto faz kernelWidth·kernelHeight multiplicações por pixel; a FFT, da ordem
+	// de log₂ do tamanho da malha completada.
+	n := fftSize(bounds.Dx()+kernelWidth-1) * fftSize(bounds.Dy()+kernelHeight-1)
+	if float64(kernelWidth*kernelHeight) > fftCost*math.Log2(float64(n)) {
+		return StrategyFFT
+	}
+	return StrategyDirect
+}
+
+// convolveSeparable aplica o kernel column⊗row como uma passada horizontal por row seguida de
+// uma vertical por column. Como os modos de borda tratam linhas e colunas separadamente, o
+// resultado é o do kernel 2D, a menos do arredondamento.
+func convolveSeparable(ctx context.Context, img *Float, column, row []float64, opts []Option) (*Float, error) {
+	direct := append(append([]Option(nil), opts...), WithStrategy(StrategyDirect))
+
+	horizontal, err := ConvolveFloatContext(ctx, img, [][]float64{row}, direct...)
+	if err != nil {
+		return nil, err
+	}
+
+	vertical := make([][]float64, len(column))
+	for i, v := range column {
+		vertical[i] = []float64{v}
+	}
+	return ConvolveFloatContext(ctx, horizontal, vertical, direct...)
+}
@@ -0,0 +1,144 @@
+package imaging_test
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/imaging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// randomKernel monta um kernel pseudoaleatório, de posto cheio, com height linhas e width colunas.
+func randomKernel(width, height int) [][]float64 {
+	rng := rand.New(rand.NewPCG(3, 4))
+	k := make([][]float64, height)
+	for i := range k {
+		k[i] = make([]float64, width)
+		for j := range k[i] {
+			k[i][j] = rng.Float64() - 0.5
+		}
+	}
+	return k
+}
+
+func TestSeparate(t *testing.T) {
+	separable := map[string][][]float64{
+		"Gaussiano 5x5": imaging.GaussianKernel5x5,
+		"Gaussiano σ=2": imaging.GaussianKernel(2),
+		"Sobel X":       imaging.SobelX,
+		"Sobel Y":       imaging.SobelY,
+		"Central O(h⁴)": imaging.CentralO4X,
+		"Forward O(h⁴)": imaging.ForwardO4Y,
+		"nulo":          {{0, 0}, {0, 0}},
+	}
+	for name, kernel := range separable {
+		t.Run(name, func(t *testing.T) {
+			column, row, ok := imaging.Separate(kernel)
+			require.True(t, ok)
+			require.Len(t, column, len(kernel))
+			require.Len(t, row, len(kernel[0]))
+			for i := range kernel {
+				for j := range kernel[i] {
+					assert.InDelta(t, kernel[i][j], column[i]*row[j], 1e-15)
+				}
+			}
+		})
+	}
+
+	// Os fatores de kernels inteiros são exatos.
+	column, row, _ := imaging.Separate(imaging.SobelX)
+	assert.Equal(t, []float64{1, 2, 1}, column)
+	assert.Equal(t, []float64{-1, 0, 1}, row)
+
+	notSeparable := map[string][][]float64{
+		"Laplaciano":  imaging.Laplacian,
+		"LoG":         imaging.LoGKernel(1),
+		"aleatório":   randomKernel(4, 3),
+		"vazio":       nil,
+		"irregular":   {{1, 2}, {3}},
+		"quase posto": {{1, 2}, {2, 4 + 1e-9}},
+	}
+	for name, kernel := range notSeparable {
+		t.Run(name, func(t *testing.T) {
+			_, _, ok := imaging.Separate(kernel)
+			assert.False(t, ok)
+		})
+	}
+}
+
+func TestConvolveFloat_Strategies(t *testing.T) {
+	img := imaging.ToFloat(noise(67, 41))
+	kernels := map[string][][]float64{
+		"Gaussiano σ=2": imaging.GaussianKernel(2),
+		"Sobel X":       imaging.SobelX,
+		"LoG σ=2":       imaging.LoGKernel(2),
+		"aleatório 7x3": randomKernel(7, 3),
+		"aleatório 4x6": randomKernel(4, 6),
+	}
+	borders := []imaging.Border{
+		imaging.BorderReflect, imaging.BorderZero, imaging.BorderReplicate,
+		imaging.BorderWrap, imaging.BorderCrop,
+	}
+	strategies := []imaging.Strategy{imaging.StrategyAuto, imaging.StrategySeparable, imaging.StrategyFFT}
+
+	for name, kernel := range kernels {
+		_, _, separable := imaging.Separate(kernel)
+		for _, border := range borders {
+			direct := imaging.ConvolveFloat(img, kernel,
+				imaging.WithBorder(border), imaging.WithStrategy(imaging.StrategyDirect))
+
+			for _, strategy := range strategies {
+				if strategy == imaging.StrategySeparable && !separable {
+					continue
+				}
+				t.Run(fmt.Sprintf("%s/%s/%s", name, border, strategy), func(t *testing.T) {
+					got := imaging.ConvolveFloat(img, kernel,
+						imaging.WithBorder(border), imaging.WithStrategy(strategy))
+
+					require.Equal(t, direct.Rect, got.Rect)
+					for i := range direct.Pix {
+						require.InDelta(t, direct.Pix[i], got.Pix[i], 1e-9*max(1, math.Abs(direct.Pix[i])), "pixel %d", i)
+					}
+				})
+			}
+		}
+	}
+}
+
+func TestConvolveFloat_FFTTinyImage(t *testing.T) {
+	// Kernel maior que a imagem: a FFT completa a imagem pelo modo de borda como o caminho direto.
+	img := imaging.ToFloat(noise(3, 2))
+	kernel := randomKernel(9, 7)
+
+	for _, border := range []imaging.Border{imaging.BorderReflect, imaging.BorderWrap, imaging.BorderCrop} {
+		direct := imaging.ConvolveFloat(img, kernel, imaging.WithBorder(border), imaging.WithStrategy(imaging.StrategyDirect))
+		fft := imaging.ConvolveFloat(img, kernel, imaging.WithBorder(border), imaging.WithStrategy(imaging.StrategyFFT))
+
+		require.Equal(t, direct.Rect, fft.Rect, border.String())
+		assert.InDeltaSlice(t, direct.Pix, fft.Pix, 1e-9, border.String())
+	}
+}
+
+func TestConvolveFloatContext_Strategy(t *testing.T) {
+	img := imaging.ToFloat(noise(32, 32))
+
+	_, err := imaging.ConvolveFloatContext(context.Background(), img, imaging.Laplacian,
+		imaging.WithStrategy(imaging.StrategySeparable))
+	assert.ErrorIs(t, err, imaging.ErrInvalidOption)
+
+	_, err = imaging.ConvolveFloatContext(context.Background(), img, imaging.Laplacian,
+		imaging.WithStrategy(imaging.Strategy(42)))
+	assert.ErrorIs(t, err, imaging.ErrInvalidOption)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	for _, strategy := range []imaging.Strategy{imaging.StrategySeparable, imaging.StrategyFFT} {
+		out, err := imaging.ConvolveFloatContext(ctx, img, imaging.GaussianKernel5x5, imaging.WithStrategy(strategy))
+		assert.ErrorIs(t, err, context.Canceled, strategy.String())
+		assert.Nil(t, out)
+	}
+}
@@ -0,0 +1,172 @@
+package imaging
+
+import (
+	"fmt"
+	"image"
+	"math"
+	"slices"
+)
+
+// otsuBins é a quantidade padrão de faixas do histograma de Otsu.
+const otsuBins = 256
+
+// ThresholdStrategy escolhe o limiar de binarização de um detector de bordas a partir dos
+// valores da sua resposta: a magnitude do gradiente em cada pixel ou, nos detectores de
+// cruzamentos por zero, o contraste de cada cruzamento. Fixed é o limiar escolhido à mão;
+// Otsu, Percentile e MeanStd se adaptam à imagem.
+type ThresholdStrategy interface {
+	// Select retorna o limiar para os valores dados; valores acima dele são bordas. Os
+	// detectores passam uma cópia da resposta, que a estratégia pode ordenar ou alterar.
+	Select(values []float64) float64
+}
+
+var (
+	_ ThresholdStrategy = Fixed(0)
+	_ ThresholdStrategy = Otsu{}
+	_ ThresholdStrategy = Percentile(0)
+	_ ThresholdStrategy = MeanStd(0)
+)
+
+// Fixed é um limiar fixo, escolhido à mão.
+type Fixed float64
+
+// Select retorna o próprio limiar.
+func (f Fixed) Select([]float64) float64 {
+	return float64(f)
+}
+
+// String retorna o limiar.
+func (f Fixed) String() string {
+	return fmt.Sprintf("fixo %g", float64(f))
+}
+
+// Otsu escolhe o limiar que maximiza a variância entre as classes borda e fundo no histograma
+// dos valores (método de Otsu). Funciona bem quando o histograma é bimodal, como o dos
+// contrastes dos cruzamentos por zero; a magnitude do gradiente costuma ter uma cauda longa,
+// em que Otsu escolhe limiares altos demais, e Percentile ou MeanStd são mais adequados.
+type Otsu struct {
+	// Bins é a quantidade de faixas do histograma entre o menor e o maior valor; 0 usa 256.
+	Bins int
+}
+
+// Select retorna o limiar de Otsu, na fronteira superior da última faixa do fundo.
+func (o Otsu) Select(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+
+	bins := o.Bins
+	if bins <= 0 {
+		bins = otsuBins
+	}
+
+	lo, hi := slices.Min(values), slices.Max(values)
+	if !(hi > lo) {
+		return lo
+	}
+	width := (hi - lo) / float64(bins)
+
+	histogram := make([]float64, bins)
+	var total float64
+	for _, v := range values {
+		b := min(int((v-lo)/width), bins-1)
+		histogram[b]++
+		total += v
+	}
+
+	// Variância entre classes: w₀w₁(μ₀ - μ₁)², com o fundo nas faixas 0..b.
+	n := float64(len(values))
+	var (
+		best           = -1.0
+		threshold      = lo
+		count, partial float64
+	)
+	for b := range bins - 1 {
+		count += histogram[b]
+		partial += histogram[b] * (lo + (float64(b)+0.5)*width)
+		if count == 0 || count == n {
+			continue
+		}
+
+		mean0 := partial / count
+		mean1 := (total - partial) / (n - count)
+		variance := count * (n - count) * (mean0 - mean1) * (mean0 - mean1)
+		if variance > best {
+			best = variance
+			threshold = lo + float64(b+1)*width
+		}
+	}
+	return threshold
+}
+
+// String retorna o nome do método.
+func (o Otsu) String() string {
+	return "Otsu"
+}
+
+// Percentile escolhe como limiar o percentil p (de 0 a 100) dos valores, com interpolação
+// linear entre as posições vizinhas: Percentile(90) deixa cerca de 10% dos valores como borda.
+// Entra em pânico se p estiver fora de [0, 100].
+type Percentile float64
+
+// Select retorna o percentil dos valores.
+func (p Percentile) Select(values []float64) float64 {
+	if !(p >= 0 && p <= 100) {
+		panic(fmt.Sprintf("imaging: percentil inválido: %g", float64(p)))
+	}
+	if len(values) == 0 {
+		return 0
+	}
+
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+
+	rank := float64(p) / 100 * float64(len(sorted)-1)
+	i := int(rank)
+	if i+1 >= len(sorted) {
+		return sorted[len(sorted)-1]
+	}
+	frac := rank - float64(i)
+	return sorted[i] + frac*(sorted[i+1]-sorted[i])
+}
+
+// String retorna o percentil.
+func (p Percentile) String() string {
+	return fmt.Sprintf("percentil %g", float64(p))
+}
+
+// MeanStd escolhe como limiar a média mais k desvios padrão dos valores, μ + k·σ.
+type MeanStd float64
+
+// Select retorna μ + k·σ.
+func (k MeanStd) Select(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+
+	var mean float64
+	for _, v := range values {
+		mean += v
+	}
+	mean /= float64(len(values))
+
+	var variance float64
+	for _, v := range values {
+		variance += (v - mean) * (v - mean)
+	}
+	variance /= float64(len(values))
+
+	return mean + float64(k)*math.Sqrt(variance)
+}
+
+// String retorna a fórmula do limiar.
+func (k MeanStd) String() string {
+	return fmt.Sprintf("μ + %g·σ", float64(k))
+}
+
+// binarize escolhe o limiar pela estratégia a partir de todos os pixels de f e binariza a
+// imagem com ele, retornando também o limiar escolhido.
+func binarize(f *Float, strategy ThresholdStrategy) (*image.Gray, float64) {
+	threshold := strategy.Select(f.values())
+	return Threshold(f, threshold), threshold
+}
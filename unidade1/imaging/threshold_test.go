@@ -0,0 +1,150 @@
+package imaging_test
+
+import (
+	"image"
+	"slices"
+	"testing"
+
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/imaging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// sortingStrategy ordena e zera os valores recebidos antes de devolver o limiar fixo.
+type sortingStrategy float64
+
+func (s sortingStrategy) Select(values []float64) float64 {
+	slices.Sort(values)
+	clear(values)
+	return float64(s)
+}
+
+// edgeFraction retorna a fração de pixels de borda (pretos) da imagem.
+func edgeFraction(img *image.Gray) float64 {
+	var edges int
+	for _, v := range img.Pix {
+		if v == 0 {
+			edges++
+		}
+	}
+	return float64(edges) / float64(len(img.Pix))
+}
+
+func TestThresholdStrategy_Select(t *testing.T) {
+	values := []float64{2, 4, 4, 4, 5, 5, 7, 9}
+
+	tests := []struct {
+		name     string
+		strategy imaging.ThresholdStrategy
+		want     float64
+	}{
+		{"fixo", imaging.Fixed(3.5), 3.5},
+		{"percentil 0", imaging.Percentile(0), 2},
+		{"percentil 50", imaging.Percentile(50), 4.5},
+		{"percentil 100", imaging.Percentile(100), 9},
+		{"percentil interpolado", imaging.Percentile(90), 7.6},
+		{"média", imaging.MeanStd(0), 5},
+		{"média + 1,5σ", imaging.MeanStd(1.5), 8},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.want, tt.strategy.Select(values), 1e-12)
+		})
+	}
+
+	assert.Equal(t, []float64{2, 4, 4, 4, 5, 5, 7, 9}, values, "os valores não são alterados")
+	assert.Panics(t, func() { imaging.Percentile(101).Select(values) })
+	assert.Panics(t, func() { imaging.Percentile(-1).Select(values) })
+}
+
+func TestOtsu(t *testing.T) {
+	// Dois grupos, em torno de 10 e de 50: o limiar fica entre eles.
+	var values []float64
+	for i := range 100 {
+		values = append(values, 8+float64(i%5), 48+float64(i%5))
+	}
+	for _, bins := range []int{0, 16, 1000} {
+		threshold := imaging.Otsu{Bins: bins}.Select(values)
+		assert.Greater(t, threshold, 12.0, "faixas %d", bins)
+		assert.LessOrEqual(t, threshold, 48.0, "faixas %d", bins)
+	}
+
+	assert.Equal(t, 7.0, imaging.Otsu{}.Select([]float64{7, 7, 7}))
+	assert.Equal(t, 0.0, imaging.Otsu{}.Select(nil))
+}
+
+func TestDetectEdges_ThresholdStrategy(t *testing.T) {
+	// Um degrau forte em x=16 e um fraco, de 46 para 50, em x=6.
+	img := step(32, 16, 16, 50, 200)
+	for y := range 16 {
+		for x := range 6 {
+			img.Pix[img.PixOffset(x, y)] = 46
+		}
+	}
+
+	detectors := []struct {
+		name   string
+		detect func(*image.Gray, imaging.ThresholdStrategy, ...imaging.Option) (*image.Gray, float64)
+	}{
+		{"Sobel", imaging.DetectEdgesSobel},
+		{"Laplaciano", imaging.DetectEdgesLaplacian},
+		{"Central O(h⁴)", imaging.DetectEdgesCentralO4},
+		{"LoG", imaging.DetectEdgesLoG},
+	}
+
+	for _, d := range detectors {
+		t.Run(d.name, func(t *testing.T) {
+			_, threshold := d.detect(img, imaging.Fixed(12))
+			assert.Equal(t, 12.0, threshold, "o limiar fixo é retornado")
+
+			// Otsu separa o degrau forte do fraco e das regiões planas.
+			edges, threshold := d.detect(img, imaging.Otsu{})
+			assert.Greater(t, threshold, 0.0)
+			for _, x := range []int{2, 5, 6, 26} {
+				assert.Equal(t, uint8(255), edges.GrayAt(x, 8).Y, "coluna %d", x)
+			}
+			assert.True(t, edges.GrayAt(15, 8).Y == 0 || edges.GrayAt(16, 8).Y == 0, "a borda deve ser detectada")
+
+			same, _ := d.detect(img, imaging.Fixed(threshold))
+			assert.Equal(t, edges.Pix, same.Pix, "o limiar retornado reproduz o resultado")
+		})
+	}
+}
+
+func TestDetectEdgesSobel_Percentile(t *testing.T) {
+	// Na magnitude do ruído quase não há empates: o percentil p deixa 100-p% de bordas.
+	img := noise(64, 64)
+	for _, p := range []float64{50, 90, 99} {
+		edges, _ := imaging.DetectEdgesSobel(img, imaging.Percentile(p))
+		assert.InDelta(t, (100-p)/100, edgeFraction(edges), 0.005, "percentil %g", p)
+	}
+}
+
+func TestDetectEdgesCanny_ThresholdStrategy(t *testing.T) {
+	img := step(32, 16, 16, 50, 200)
+
+	edges, low, high := imaging.DetectEdgesCanny(img, imaging.Percentile(95), imaging.Percentile(80))
+	require.Less(t, low, high, "os limiares invertidos são trocados")
+
+	same, _, _ := imaging.DetectEdgesCanny(img, imaging.Fixed(low), imaging.Fixed(high))
+	assert.Equal(t, edges.Pix, same.Pix)
+	assert.NotEmpty(t, edgeColumns(edges, 8))
+}
+
+func TestThresholdStrategy_MayModifyValues(t *testing.T) {
+	// Uma estratégia que altera os valores não pode corromper a resposta do detector.
+	img := step(32, 16, 16, 50, 200)
+
+	want, _ := imaging.DetectEdgesSobel(img, imaging.Fixed(100))
+	got, _ := imaging.DetectEdgesSobel(img, sortingStrategy(100))
+	assert.Equal(t, want.Pix, got.Pix, "Sobel")
+
+	wantCanny, _, _ := imaging.DetectEdgesCanny(img, imaging.Fixed(20), imaging.Fixed(60))
+	gotCanny, _, _ := imaging.DetectEdgesCanny(img, sortingStrategy(20), sortingStrategy(60))
+	assert.Equal(t, wantCanny.Pix, gotCanny.Pix, "Canny")
+	assert.NotEmpty(t, edgeColumns(gotCanny, 8))
+
+	wantLoG, _ := imaging.DetectEdgesLoG(img, imaging.Fixed(5))
+	gotLoG, _ := imaging.DetectEdgesLoG(img, sortingStrategy(5))
+	assert.Equal(t, wantLoG.Pix, gotLoG.Pix, "LoG")
+}
@@ -1,4 +1,4 @@
-// Package imaging fornece funções para carregar e salvar imagens em tons de cinza.
+// Package imaging fornece funções para carregar e salvar imagens e detectar suas bordas.
 package imaging
 
 import (
@@ -11,8 +11,8 @@ import (
 	"path/filepath"
 )
 
-// LoadImageGrayscale carrega uma imagem do disco e a converte para tons de cinza.
-func LoadImageGrayscale(filename string) *image.Gray {
+// LoadImage carrega uma imagem do disco, mantendo as cores.
+func LoadImage(filename string) image.Image {
 	// ./data + filename
 	filename = filepath.Join("data", filename)
 
@@ -28,6 +28,13 @@ func LoadImageGrayscale(filename string) *image.Gray {
 		slog.Error("Falha ao decodificar a imagem", slog.Any("error", err))
 		os.Exit(1)
 	}
+	return img
+}
+
+// LoadImageGrayscale carrega uma imagem do disco e a converte para tons de cinza. Para manter
+// as cores, use LoadImage.
+func LoadImageGrayscale(filename string) *image.Gray {
+	img := LoadImage(filename)
 
 	// Converte para tons de cinza para simplificar os cálculos
 	grayImg := image.NewGray(img.Bounds())
@@ -35,8 +42,9 @@ func LoadImageGrayscale(filename string) *image.Gray {
 	return grayImg
 }
 
-// SaveImage salva uma imagem em tons de cinza no disco.
-func SaveImage(path string, img *image.Gray) {
+// SaveImage salva uma imagem no disco, como um mapa de bordas em tons de cinza ou uma
+// sobreposição colorida.
+func SaveImage(path string, img image.Image) {
 	path = filepath.Join("data", path)
 	file, err := os.Create(path)
 	if err != nil {
@@ -0,0 +1,304 @@
+package imaging
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	"log/slog"
+	"math"
+)
+
+// dogRatio é a razão entre os desvios padrão das duas Gaussianas da diferença de Gaussianas.
+// Com 1,6, a DoG aproxima bem o Laplaciano da Gaussiana (Marr e Hildreth).
+const dogRatio = 1.6
+
+// LaplacianFilter define como o Laplaciano da Gaussiana é calculado em DetectEdgesLoG.
+type LaplacianFilter int
+
+const (
+	// FilterLoG convolui a imagem com o kernel σ²∇²G de LoGKernel.
+	FilterLoG LaplacianFilter = iota
+	// FilterDoG aproxima σ²∇²G pela diferença de Gaussianas [G(1,6σ) - G(σ)]/0,6.
+	FilterDoG
+)
+
+// String retorna o nome do filtro.
+func (f LaplacianFilter) String() string {
+	switch f {
+	case FilterLoG:
+		return "LoG"
+	case FilterDoG:
+		return "DoG"
+	default:
+		return fmt.Sprintf("LaplacianFilter(%d)", int(f))
+	}
+}
+
+// ScaleCombination define como DetectEdgesLoG combina as bordas de várias escalas.
+type ScaleCombination int
+
+const (
+	// CombineAny marca os cruzamentos por zero encontrados em qualquer escala.
+	CombineAny ScaleCombination = iota
+	// CombineAll marca os cruzamentos da menor escala confirmados, a até um pixel de
+	// distância, em todas as outras. Descarta o ruído, que só cruza zero nas escalas finas,
+	// mantendo a localização da escala fina.
+	CombineAll
+)
+
+// String retorna o nome da combinação.
+func (c ScaleCombination) String() string {
+	switch c {
+	case CombineAny:
+		return "qualquer"
+	case CombineAll:
+		return "todas"
+	default:
+		return fmt.Sprintf("ScaleCombination(%d)", int(c))
+	}
+}
+
+// WithLaplacianFilter escolhe o cálculo do Laplaciano da Gaussiana em DetectEdgesLoG.
+// O padrão é FilterLoG.
+func WithLaplacianFilter(filter LaplacianFilter) Option {
+	return func(o *options) {
+		o.filter = filter
+	}
+}
+
+// WithScales faz DetectEdgesLoG procurar bordas nos desvios padrão sigmas, substituindo
+// WithSigma.
+func WithScales(sigmas ...float64) Option {
+	return func(o *options) {
+		o.scales = append([]float64(nil), sigmas...)
+	}
+}
+
+// WithCombination escolhe como DetectEdgesLoG combina as escalas. O padrão é CombineAny.
+func WithCombination(combination ScaleCombination) Option {
+	return func(o *options) {
+		o.combination = combination
+	}
+}
+
+// LoGKernel monta o kernel do Laplaciano da Gaussiana normalizado pela escala, σ²∇²G, com
+// raio ⌈4σ⌉. A normalização deixa as respostas de escalas diferentes comparáveis, e a média é
+// removida para que regiões constantes tenham resposta nula. Entra em pânico se sigma não
+// for positivo.
+func LoGKernel(sigma float64) [][]float64 {
+	if !(sigma > 0) || math.IsInf(sigma, 0) {
+		panic(fmt.Sprintf("imaging: desvio padrão inválido: %g", sigma))
+	}
+
+	radius := max(1, int(math.Ceil(4*sigma)))
+	size := 2*radius + 1
+	s2 := sigma * sigma
+
+	kernel := make([][]float64, size)
+	var total float64
+	for i := range kernel {
+		kernel[i] = make([]float64, size)
+		for j := range kernel[i] {
+			r2 := float64((i-radius)*(i-radius) + (j-radius)*(j-radius))
+			// σ²∇²G = (r² - 2σ²)/σ² · exp(-r²/2σ²)/(2πσ²)
+			kernel[i][j] = (r2 - 2*s2) / s2 * math.Exp(-r2/(2*s2)) / (2 * math.Pi * s2)
+			total += kernel[i][j]
+		}
+	}
+
+	mean := total / float64(size*size)
+	for i := range kernel {
+		for j := range kernel[i] {
+			kernel[i][j] -= mean
+		}
+	}
+	return kernel
+}
+
+// DetectEdgesLoG implementa o detector de Marr–Hildreth: as bordas são os cruzamentos por
+// zero de σ²∇²G aplicado à imagem, isto é, os pares de pixels vizinhos (na horizontal ou na
+// vertical) com respostas de sinais opostos. Só contam os cruzamentos em que a diferença
+// entre as duas respostas passa do contraste mínimo, o que descarta as oscilações das regiões
+// planas. Em cada par, a borda fica no pixel de resposta mais próxima de zero.
+//
+// O contraste mínimo vem da estratégia contrast, aplicada aos contrastes dos cruzamentos de
+// todas as escalas, e é retornado junto com as bordas.
+//
+// O desvio padrão vem de WithSigma ou, para várias escalas, de WithScales, combinadas conforme
+// WithCombination. WithLaplacianFilter troca o LoG pela diferença de Gaussianas.
+func DetectEdgesLoG(inputImg *image.Gray, contrast ThresholdStrategy, opts ...Option) (*image.Gray, float64) {
+	o := newOptions(opts)
+
+	scales := o.scales
+	if len(scales) == 0 {
+		scales = []float64{o.sigma}
+	}
+
+	img := ToFloat(inputImg)
+
+	measured := make([]*Float, len(scales))
+	for i, sigma := range scales {
+		measured[i] = zeroCrossings(laplacianOfGaussian(img, sigma, o.filter, opts))
+	}
+	minContrast := contrast.Select(crossingContrasts(measured...))
+
+	slog.DebugContext(context.Background(), "Aplicando o detector de cruzamentos por zero",
+		slog.String("filtro", o.filter.String()),
+		slog.Any("escalas", scales),
+		slog.String("combinação", o.combination.String()),
+		slog.Float64("contraste", minContrast))
+
+	crossings := make([]*crossingMap, len(scales))
+	for i, m := range measured {
+		crossings[i] = crossingsAbove(m, minContrast)
+	}
+
+	// Com BorderCrop cada escala tem limites próprios; o resultado fica na interseção.
+	bounds := crossings[0].rect
+	finest := 0
+	for i, c := range crossings {
+		bounds = bounds.Intersect(c.rect)
+		if scales[i] < scales[finest] {
+			finest = i
+		}
+	}
+
+	combined := &crossingMap{marks: make([]bool, bounds.Dx()*bounds.Dy()), rect: bounds}
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			var edge bool
+			switch o.combination {
+			case CombineAll:
+				edge = crossings[finest].at(x, y)
+				for _, c := range crossings {
+					edge = edge && c.near(x, y)
+				}
+			default:
+				for _, c := range crossings {
+					edge = edge || c.at(x, y)
+				}
+			}
+			combined.marks[(y-bounds.Min.Y)*bounds.Dx()+(x-bounds.Min.X)] = edge
+		}
+	}
+	return edgeMap(combined), minContrast
+}
+
+// laplacianOfGaussian retorna σ²∇²G aplicado à imagem, pelo kernel LoG ou pela DoG.
+func laplacianOfGaussian(img *Float, sigma float64, filter LaplacianFilter, opts []Option) *Float {
+	if filter != FilterDoG {
+		return ConvolveFloat(img, LoGKernel(sigma), opts...)
+	}
+
+	// G(kσ) - G(σ) ≈ (k-1)σ²∇²G. Com BorderCrop, a Gaussiana larga tem limites menores, que
+	// estão contidos nos da estreita; a subtração é feita pelas coordenadas.
+	wide := ConvolveFloat(img, GaussianKernel(dogRatio*sigma), opts...)
+	narrow := ConvolveFloat(img, GaussianKernel(sigma), opts...)
+	for y := wide.Rect.Min.Y; y < wide.Rect.Max.Y; y++ {
+		for x := wide.Rect.Min.X; x < wide.Rect.Max.X; x++ {
+			i := wide.PixOffset(x, y)
+			wide.Pix[i] = (wide.Pix[i] - narrow.At(x, y)) / (dogRatio - 1)
+		}
+	}
+	return wide
+}
+
+// crossingMap marca os cruzamentos por zero de uma resposta.
+type crossingMap struct {
+	// marks[(y-rect.Min.Y)*rect.Dx() + (x-rect.Min.X)] indica um cruzamento em (x, y).
+	marks []bool
+	// rect são os limites da resposta.
+	rect image.Rectangle
+}
+
+// at indica se há um cruzamento em (x, y).
+func (c *crossingMap) at(x, y int) bool {
+	if !(image.Point{X: x, Y: y}.In(c.rect)) {
+		return false
+	}
+	return c.marks[(y-c.rect.Min.Y)*c.rect.Dx()+(x-c.rect.Min.X)]
+}
+
+// near indica se há um cruzamento a até um pixel (vizinhança de 8) de (x, y).
+func (c *crossingMap) near(x, y int) bool {
+	for ny := y - 1; ny <= y+1; ny++ {
+		for nx := x - 1; nx <= x+1; nx++ {
+			if c.at(nx, ny) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// zeroCrossings mede os cruzamentos por zero da resposta com o vizinho da direita ou de
+// baixo. O cruzamento fica no pixel do par com resposta de menor valor absoluto, que recebe a
+// diferença entre as duas respostas (o contraste) ou, se houver mais de um par, a maior delas.
+// Os pixels sem cruzamento ficam com 0.
+func zeroCrossings(response *Float) *Float {
+	bounds := response.Rect
+	width, height := bounds.Dx(), bounds.Dy()
+	out := NewFloat(bounds)
+
+	for y := range height {
+		for x := range width {
+			v := response.Pix[y*response.Stride+x]
+			for _, d := range [][2]int{{1, 0}, {0, 1}} {
+				nx, ny := x+d[0], y+d[1]
+				if nx >= width || ny >= height {
+					continue
+				}
+
+				n := response.Pix[ny*response.Stride+nx]
+				if !(v < 0 && n > 0 || v > 0 && n < 0) {
+					continue
+				}
+				i := ny*width + nx
+				if math.Abs(v) <= math.Abs(n) {
+					i = y*width + x
+				}
+				out.Pix[i] = max(out.Pix[i], math.Abs(v-n))
+			}
+		}
+	}
+	return out
+}
+
+// crossingContrasts retorna os contrastes de todos os cruzamentos medidos por zeroCrossings,
+// que são os valores a partir dos quais uma ThresholdStrategy escolhe o contraste mínimo.
+func crossingContrasts(crossings ...*Float) []float64 {
+	var contrasts []float64
+	for _, c := range crossings {
+		for _, v := range c.Pix {
+			if v > 0 {
+				contrasts = append(contrasts, v)
+			}
+		}
+	}
+	return contrasts
+}
+
+// crossingsAbove marca os cruzamentos com contraste maior que contrast.
+func crossingsAbove(crossings *Float, contrast float64) *crossingMap {
+	marks := make([]bool, len(crossings.Pix))
+	for i, v := range crossings.Pix {
+		marks[i] = v > 0 && v > contrast
+	}
+	return &crossingMap{marks: marks, rect: crossings.Rect}
+}
+
+// edgeMap converte os cruzamentos em uma imagem com as bordas em preto sobre fundo branco.
+func edgeMap(c *crossingMap) *image.Gray {
+	out := image.NewGray(c.rect)
+	for y := c.rect.Min.Y; y < c.rect.Max.Y; y++ {
+		for x := c.rect.Min.X; x < c.rect.Max.X; x++ {
+			if c.at(x, y) {
+				out.SetGray(x, y, color.Gray{Y: 0}) // Borda (preto)
+			} else {
+				out.SetGray(x, y, color.Gray{Y: 255}) // Fundo (branco)
+			}
+		}
+	}
+	return out
+}
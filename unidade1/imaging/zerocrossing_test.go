@@ -0,0 +1,131 @@
+package imaging_test
+
+import (
+	"image"
+	"testing"
+
+	"github.com/ArtroxGabriel/numeric-methods-2/unidade1/imaging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoGKernel(t *testing.T) {
+	k := imaging.LoGKernel(1.5)
+	require.Len(t, k, 13)
+
+	var total float64
+	for i := range k {
+		for j := range k[i] {
+			total += k[i][j]
+			assert.InDelta(t, k[i][j], k[j][i], 1e-15)
+		}
+	}
+	assert.InDelta(t, 0, total, 1e-12)
+	assert.Less(t, k[6][6], 0.0, "o centro é negativo")
+	assert.Greater(t, k[6][9], 0.0, "o anel, a partir de √2σ, é positivo")
+
+	assert.Panics(t, func() { imaging.LoGKernel(-1) })
+}
+
+func TestDetectEdgesLoG_Step(t *testing.T) {
+	filters := []imaging.LaplacianFilter{imaging.FilterLoG, imaging.FilterDoG}
+
+	for _, filter := range filters {
+		t.Run(filter.String(), func(t *testing.T) {
+			for _, img := range [][]uint8{{50, 200}, {200, 50}} {
+				edges, _ := imaging.DetectEdgesLoG(step(24, 12, 12, img[0], img[1]), imaging.Fixed(5),
+					imaging.WithLaplacianFilter(filter))
+
+				// Um único pixel de borda por linha, junto ao degrau.
+				for y := range 12 {
+					cols := edgeColumns(edges, y)
+					require.Len(t, cols, 1, "linha %d", y)
+					assert.InDelta(t, 11.5, float64(cols[0]), 0.5, "linha %d", y)
+				}
+			}
+		})
+	}
+}
+
+func TestDetectEdgesLoG_Contrast(t *testing.T) {
+	weak := step(24, 12, 12, 100, 110)
+
+	// O degrau fraco cruza zero, mas com pouco contraste.
+	low, _ := imaging.DetectEdgesLoG(weak, imaging.Fixed(1))
+	high, _ := imaging.DetectEdgesLoG(weak, imaging.Fixed(10))
+	assert.Contains(t, low.Pix, uint8(0))
+	assert.NotContains(t, high.Pix, uint8(0))
+
+	// Numa imagem constante não há cruzamentos, mesmo sem limiar.
+	flat := step(24, 12, 12, 100, 100)
+	edges, _ := imaging.DetectEdgesLoG(flat, imaging.Fixed(0))
+	assert.NotContains(t, edges.Pix, uint8(0))
+}
+
+func TestDetectEdgesLoG_Scales(t *testing.T) {
+	// Um degrau em x=20 e um pixel de ruído em (8, 15), que só cruza zero na escala fina.
+	img := step(40, 30, 20, 50, 200)
+	img.Pix[img.PixOffset(8, 15)] = 110
+
+	noise := func(edges []int) bool {
+		for _, x := range edges {
+			if x < 15 {
+				return true
+			}
+		}
+		return false
+	}
+
+	fine, _ := imaging.DetectEdgesLoG(img, imaging.Fixed(5), imaging.WithSigma(1))
+	coarse, _ := imaging.DetectEdgesLoG(img, imaging.Fixed(5), imaging.WithSigma(3))
+	assert.True(t, noise(edgeColumns(fine, 15)))
+	assert.False(t, noise(edgeColumns(coarse, 15)))
+
+	anyScale, _ := imaging.DetectEdgesLoG(img, imaging.Fixed(5), imaging.WithScales(1, 3))
+	assert.Equal(t, edgeColumns(fine, 15)[:2], edgeColumns(anyScale, 15)[:2], "o ruído da escala fina é mantido")
+
+	allScales, _ := imaging.DetectEdgesLoG(img, imaging.Fixed(5),
+		imaging.WithScales(3, 1),
+		imaging.WithCombination(imaging.CombineAll))
+	for y := range 30 {
+		cols := edgeColumns(allScales, y)
+		assert.False(t, noise(cols), "linha %d", y)
+
+		// A localização vem da escala fina.
+		var step []int
+		for _, x := range edgeColumns(fine, y) {
+			if x >= 15 {
+				step = append(step, x)
+			}
+		}
+		assert.NotEmpty(t, cols, "linha %d", y)
+		assert.Equal(t, step, cols, "linha %d", y)
+	}
+}
+
+func TestDetectEdgesLoG_DoGCrop(t *testing.T) {
+	// Com BorderCrop as duas Gaussianas da DoG têm limites diferentes; numa rampa a DoG é
+	// nula e não há cruzamentos.
+	ramp := image.NewGray(image.Rect(0, 0, 48, 40))
+	for y := range 40 {
+		for x := range 48 {
+			ramp.Pix[ramp.PixOffset(x, y)] = uint8(4 * x)
+		}
+	}
+	opts := []imaging.Option{
+		imaging.WithLaplacianFilter(imaging.FilterDoG),
+		imaging.WithBorder(imaging.BorderCrop),
+	}
+	edges, _ := imaging.DetectEdgesLoG(ramp, imaging.Fixed(0.5), opts...)
+	assert.Less(t, edges.Bounds().Dx(), 48)
+	assert.NotContains(t, edges.Pix, uint8(0))
+
+	// O degrau continua na mesma coluna do modo de borda padrão.
+	img := step(48, 40, 24, 50, 200)
+	cropped, _ := imaging.DetectEdgesLoG(img, imaging.Fixed(5), opts...)
+	for y := cropped.Bounds().Min.Y; y < cropped.Bounds().Max.Y; y++ {
+		cols := edgeColumns(cropped, y)
+		require.Len(t, cols, 1, "linha %d", y)
+		assert.InDelta(t, 23.5, float64(cols[0]), 0.5, "linha %d", y)
+	}
+}
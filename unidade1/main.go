@@ -21,39 +21,74 @@ func main() {
 
 	// --- Executa o Algoritmo 1: Sobel ---
 	slog.Info("Aplicando detecção de bordas com Sobel...")
-	sobelThreshold := 100.0 // Valor experimental, ajuste conforme necessário
-	sobelEdges := imaging.DetectEdgesSobel(originalImg, sobelThreshold)
+	// Limiar de dois desvios padrão acima da média da magnitude do gradiente
+	sobelEdges, sobelThreshold := imaging.DetectEdgesSobel(originalImg, imaging.MeanStd(2))
 	imaging.SaveImage("resultado_sobel.png", sobelEdges)
-	slog.Info("Resultado do Sobel salvo em 'resultado_sobel.png'")
+	slog.Info("Resultado do Sobel salvo em 'resultado_sobel.png'", slog.Float64("limiar", sobelThreshold))
 
 	// --- Executa o Algoritmo 2: Laplace ---
 	slog.Info("Aplicando detecção de bordas com Laplace...")
-	laplaceTolerance := 5.0 // Valor experimental, ajuste conforme necessário
-	laplaceEdges := imaging.DetectEdgesLaplacian(originalImg, laplaceTolerance)
+	// Otsu separa os cruzamentos por zero de pouco contraste dos de muito contraste
+	laplaceEdges, laplaceTolerance := imaging.DetectEdgesLaplacian(originalImg, imaging.Otsu{})
 	imaging.SaveImage("resultado_laplace.png", laplaceEdges)
-	slog.Info("Resultado do Laplace salvo em 'resultado_laplace.png'")
+	slog.Info("Resultado do Laplace salvo em 'resultado_laplace.png'", slog.Float64("tolerância", laplaceTolerance))
 
-	centralO4Threshold := 15.0
-	centralO4Edges := imaging.DetectEdgesCentralO4(originalImg, centralO4Threshold)
+	// Os kernels O(h⁴) marcam como borda os 5% de pixels com maior magnitude
+	o4Threshold := imaging.Percentile(95)
 
 	// --- Executa o Algoritmo 3: Central O(h⁴) ---
+	centralO4Edges, centralO4Threshold := imaging.DetectEdgesCentralO4(originalImg, o4Threshold)
 	imaging.SaveImage("resultado_central.png", centralO4Edges)
-	slog.Info("Resultado do Central O(h⁴) salvo em 'resultado_central.png'")
-
-	backwardO4Threshold := 15.0
-	backwardO4Edges := imaging.DetectEdgesBackward04(originalImg, backwardO4Threshold)
+	slog.Info("Resultado do Central O(h⁴) salvo em 'resultado_central.png'", slog.Float64("limiar", centralO4Threshold))
 
 	// --- Executa o Algoritmo 4: Backward O(h⁴) ---
+	backwardO4Edges, backwardO4Threshold := imaging.DetectEdgesBackward04(originalImg, o4Threshold)
 	imaging.SaveImage("resultado_backward.png", backwardO4Edges)
-	slog.Info("Resultado do Backward O(h⁴) salvo em 'resultado_backward.png'")
-
-	// forward
-	forwardO4Threshold := 15.0
-	forwardO4Edges := imaging.DetectEdgesForward04(originalImg, forwardO4Threshold)
+	slog.Info("Resultado do Backward O(h⁴) salvo em 'resultado_backward.png'", slog.Float64("limiar", backwardO4Threshold))
 
 	// --- Executa o Algoritmo 5: Forward O(h⁴) ---
+	forwardO4Edges, forwardO4Threshold := imaging.DetectEdgesForward04(originalImg, o4Threshold)
 	imaging.SaveImage("resultado_forward.png", forwardO4Edges)
-	slog.Info("Resultado do Forward O(h⁴) salvo em 'resultado_forward.png'")
+	slog.Info("Resultado do Forward O(h⁴) salvo em 'resultado_forward.png'", slog.Float64("limiar", forwardO4Threshold))
+
+	// --- Executa o Algoritmo 6: Canny com gradiente Central O(h⁴) ---
+	slog.Info("Aplicando detecção de bordas com Canny...")
+	// Limiares da histerese nos percentis 80 e 90 da magnitude do gradiente
+	cannyEdges, cannyLow, cannyHigh := imaging.DetectEdgesCanny(originalImg,
+		imaging.Percentile(80), imaging.Percentile(90),
+		imaging.WithSigma(1.4),
+		imaging.WithGradient(imaging.CentralO4Operator),
+	)
+	imaging.SaveImage("resultado_canny.png", cannyEdges)
+	slog.Info("Resultado do Canny salvo em 'resultado_canny.png'",
+		slog.Float64("low", cannyLow),
+		slog.Float64("high", cannyHigh))
+
+	// --- Executa o Algoritmo 7: cruzamentos por zero do LoG em várias escalas ---
+	slog.Info("Aplicando detecção de bordas com LoG em várias escalas...")
+	// Contraste mínimo de um desvio padrão acima da média dos cruzamentos por zero
+	logEdges, logContrast := imaging.DetectEdgesLoG(originalImg, imaging.MeanStd(1),
+		imaging.WithScales(1, 2, 4),
+		imaging.WithCombination(imaging.CombineAll),
+	)
+	imaging.SaveImage("resultado_log.png", logEdges)
+	slog.Info("Resultado do LoG salvo em 'resultado_log.png'", slog.Float64("contraste", logContrast))
+
+	// --- Executa o Algoritmo 8: Canny em cores, pelo tensor de estrutura de Di Zenzo ---
+	slog.Info("Aplicando detecção de bordas em cores...")
+	colorImg := imaging.LoadImage("pngwing.com.png")
+	// Em Lab, as bordas entre cores de mesma luminância também aparecem
+	magnitude, orientation := imaging.ColorGradient(colorImg, imaging.WithColorSpace(imaging.ColorLab))
+	colorEdges, colorLow, colorHigh := imaging.CannyFromGradient(magnitude, orientation,
+		imaging.Percentile(80), imaging.Percentile(90),
+	)
+	imaging.SaveImage("resultado_cor.png", colorEdges)
+
+	// Sobreposição das bordas à imagem, com a cor indicando a orientação do gradiente
+	imaging.SaveImage("resultado_cor_overlay.png", imaging.OrientationOverlay(colorImg, colorEdges, orientation))
+	slog.Info("Resultado em cores salvo em 'resultado_cor.png' e 'resultado_cor_overlay.png'",
+		slog.Float64("low", colorLow),
+		slog.Float64("high", colorHigh))
 
 	slog.Info("Processamento concluído.")
 }